@@ -0,0 +1,220 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/AccelByte/extend-churn-intervention/internal/bootstrap"
+	"github.com/AccelByte/extend-churn-intervention/internal/replay"
+	"github.com/AccelByte/extend-churn-intervention/internal/simulation"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	"github.com/sirupsen/logrus"
+)
+
+// commands are the offline sub-commands, keyed by name. Each receives the arguments
+// after its name and returns the process exit code.
+var commands = map[string]func(args []string) int{
+	"lint":     runLint,
+	"replay":   runReplay,
+	"schema":   runSchema,
+	"simulate": runSimulate,
+}
+
+// runLint checks pipeline configuration files without connecting to AGS or Redis (see pipeline.Lint).
+// It exits 1 if any file has errors, or warnings with -strict.
+func runLint(args []string) int {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lint [-strict] [-namespace <namespace>] [-v] [pipeline.yaml...]")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Checks pipeline configurations (default config/pipeline.yaml) the way the service loads them,")
+		fmt.Fprintln(flags.Output(), "and reports every problem as file:line:column.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+
+	strict := flags.Bool("strict", false, "fail on warnings too")
+	namespace := flags.String("namespace", "lint", "AGS namespace passed to rules and actions")
+	verbose := flags.Bool("v", false, "show pipeline logs")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"config/pipeline.yaml"}
+	}
+
+	setupCommandLogging(*verbose)
+
+	factories, err := bootstrap.InitLintFactories(*namespace)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "lint failed: %v\n", err)
+		return 1
+	}
+
+	failed := false
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "lint failed: %v\n", err)
+			failed = true
+			continue
+		}
+
+		errors, warnings := 0, 0
+		for _, issue := range pipeline.Lint(data, factories) {
+			fmt.Printf("%s:%s\n", path, issue)
+			if issue.Severity == pipeline.LintError {
+				errors++
+			} else {
+				warnings++
+			}
+		}
+
+		if errors > 0 || (*strict && warnings > 0) {
+			failed = true
+		}
+		fmt.Fprintf(os.Stderr, "%s: %d errors, %d warnings\n", path, errors, warnings)
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// runSchema prints the JSON Schema of pipeline.yaml for the registered rule and action types
+// (see pipeline.JSONSchema). config/pipeline.schema.json is generated with it.
+func runSchema(args []string) int {
+	flags := flag.NewFlagSet("schema", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: schema > config/pipeline.schema.json")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Prints the JSON Schema of pipeline.yaml, with the parameters of every registered rule and action type.")
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	setupCommandLogging(false)
+
+	if err := bootstrap.RegisterOfflineTypes("schema"); err != nil {
+		fmt.Fprintf(os.Stderr, "schema failed: %v\n", err)
+		return 1
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(pipeline.JSONSchema()); err != nil {
+		fmt.Fprintf(os.Stderr, "schema failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runReplay replays recorded events through the pipeline (see internal/replay).
+func runReplay(args []string) int {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: replay -events <events.jsonl> [-config <pipeline.yaml>] [-namespace <namespace>] [-v]")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Feeds recorded OauthTokenGenerated and StatItemUpdated events (protojson, one per line)")
+		fmt.Fprintln(flags.Output(), "through the pipeline with in-memory state, recording AGS calls instead of making them.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+
+	opts := replay.Options{}
+	flags.StringVar(&opts.EventsPath, "events", "", "recorded events file (required)")
+	flags.StringVar(&opts.ConfigPath, "config", "config/pipeline.yaml", "pipeline configuration to replay against")
+	flags.StringVar(&opts.Namespace, "namespace", "", "AGS namespace (default: namespace of the first event)")
+	verbose := flags.Bool("v", false, "show pipeline logs")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if opts.EventsPath == "" {
+		flags.Usage()
+		return 2
+	}
+
+	setupCommandLogging(*verbose)
+
+	if err := replay.Run(context.Background(), opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "replay failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runSimulate backtests pipeline configurations against a synthetic population (see internal/simulation).
+func runSimulate(args []string) int {
+	flags := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: simulate [-scenario <simulation.yaml>] [-config <pipeline.yaml>]... [-namespace <namespace>] [-v]")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Runs a synthetic player population through each pipeline configuration under a virtual clock")
+		fmt.Fprintln(flags.Output(), "and compares trigger rates, intervention volume and cost. Repeat -config to compare configs.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+
+	opts := simulation.Options{}
+	var configPaths stringList
+	flags.StringVar(&opts.ScenarioPath, "scenario", "config/simulation.yaml", "simulation scenario")
+	flags.Var(&configPaths, "config", "pipeline configuration to simulate, repeatable (default config/pipeline.yaml)")
+	flags.StringVar(&opts.Namespace, "namespace", simulation.DefaultNamespace, "AGS namespace of the simulated events")
+	verbose := flags.Bool("v", false, "show pipeline logs")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	opts.ConfigPaths = configPaths
+	if len(opts.ConfigPaths) == 0 {
+		opts.ConfigPaths = []string{"config/pipeline.yaml"}
+	}
+
+	setupCommandLogging(*verbose)
+
+	if err := simulation.Run(context.Background(), opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "simulation failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// setupCommandLogging keeps pipeline logs out of a command's report: they go to
+// stderr in text form, and only errors are shown unless verbose.
+func setupCommandLogging(verbose bool) {
+	logrusLevel, slogLevel := logrus.ErrorLevel, slog.LevelError
+	if verbose {
+		logrusLevel, slogLevel = logrus.DebugLevel, slog.LevelDebug
+	}
+
+	logrus.SetOutput(os.Stderr)
+	logrus.SetFormatter(&logrus.TextFormatter{})
+	logrus.SetLevel(logrusLevel)
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slogLevel})))
+}
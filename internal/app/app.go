@@ -6,33 +6,50 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/AccelByte/extend-churn-intervention/internal/bootstrap"
 	"github.com/AccelByte/extend-churn-intervention/internal/config"
 	"github.com/AccelByte/extend-churn-intervention/internal/server"
+	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/audit"
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/handler"
+	"github.com/AccelByte/extend-churn-intervention/pkg/health"
 	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/cenkalti/backoff/v4"
 
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/factory"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/repository"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/social"
 	sdkAuth "github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth/validator"
 	"github.com/go-redis/redis/v8"
 	"github.com/sirupsen/logrus"
 
 	actionBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/action/builtin"
 )
 
+// adminTokenValidatorRefreshInterval is how often the admin token validator refreshes
+// IAM signing keys and revocation lists.
+const adminTokenValidatorRefreshInterval = 5 * time.Minute
+
 // App holds all application dependencies and manages the application lifecycle.
 type App struct {
 	cfg               *config.Config
 	grpcServer        *server.GRPCServer
+	pipelineManager   *pipeline.Manager
+	pipelineReloaders []*bootstrap.PipelineReloader // Default config first, then namespace configs
 	metricsServer     *server.MetricsServer
+	gatewayServer     *server.GatewayServer
+	healthChecker     *health.Checker
 	redisClient       *redis.Client
+	auditFileSink     *audit.FileSink
 	shutdownTelemetry func(context.Context) error
 
 	// AccelByte SDK repositories (shared across all services)
@@ -98,6 +115,8 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	// ============================================================
 	stateStore := service.NewRedisChurnStateStore(app.redisClient, service.RedisChurnStateStoreConfig{})
 	loginTrackingStore := service.NewRedisLoginSessionTrackingStore(app.redisClient, service.RedisLoginSessionTrackingStoreConfig{})
+	statCycleStore := service.NewRedisStatCycleStore(app.redisClient)
+	sessionTracker := service.NewRedisSessionTracker(app.redisClient)
 	itemGranter := app.initItemGranter()
 	userStatUpdater := app.initStatisticService()
 
@@ -113,13 +132,20 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	processor := bootstrap.InitSignalProcessor(
 		stateStore,
 		loginTrackingStore,
+		statCycleStore,
+		sessionTracker,
 		cfg.ABNamespace,
+		clock.Real{},
 	)
+	if err := bootstrap.RegisterSignalMappings(processor, pipelineConfig); err != nil {
+		return nil, fmt.Errorf("failed to register signal mappings: %w", err)
+	}
 
 	ruleEngine, ruleRegistry, err := bootstrap.InitRuleEngine(pipelineConfig, loginTrackingStore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to init rule engine: %w", err)
 	}
+	ruleEngine.SetCooldownStore(service.NewRedisRuleCooldownStore(app.redisClient))
 
 	// ============================================================
 	// DEVELOPER: Action dependencies setup
@@ -141,8 +167,42 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("failed to init action executor: %w", err)
 	}
 
+	actionExecutor.EnableAsync(action.AsyncConfig{
+		Workers:   cfg.AsyncActionWorkers,
+		QueueSize: cfg.AsyncActionQueueSize,
+	})
+	logrus.Infof("async action execution enabled: workers=%d queueSize=%d",
+		cfg.AsyncActionWorkers, cfg.AsyncActionQueueSize)
+
 	pipelineManager := bootstrap.InitPipeline(processor, ruleEngine, actionExecutor, pipelineConfig)
 
+	if cfg.EventDedupEnabled {
+		pipelineManager.SetDeduplicator(service.NewRedisEventDeduplicationStore(app.redisClient,
+			service.RedisEventDeduplicationStoreConfig{TTL: cfg.EventDedupTTL, LeaseTTL: cfg.EventDedupLease}))
+		logrus.Infof("event deduplication enabled with TTL %v and lease %v", cfg.EventDedupTTL, cfg.EventDedupLease)
+	}
+
+	if cfg.EventLatenessWindow > 0 {
+		pipelineManager.SetLatenessWindow(cfg.EventLatenessWindow)
+		logrus.Infof("late events dropped after %v", cfg.EventLatenessWindow)
+	}
+
+	auditSink, err := app.initAuditSink()
+	if err != nil {
+		return nil, fmt.Errorf("failed to init audit sink: %w", err)
+	}
+	if auditSink != nil {
+		pipelineManager.SetAuditSink(auditSink)
+	}
+
+	pipelineManager.EnableLanes(pipeline.LaneConfig{
+		Shards:     cfg.PipelineLaneShards,
+		QueueDepth: cfg.PipelineLaneQueueDepth,
+	})
+	app.pipelineManager = pipelineManager
+	logrus.Infof("per-player ordered processing enabled: shards=%d queueDepth=%d",
+		cfg.PipelineLaneShards, cfg.PipelineLaneQueueDepth)
+
 	// ============================================================
 	// Validate pipeline wiring
 	// ============================================================
@@ -154,15 +214,62 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	}
 	logrus.Info("pipeline wiring validation passed")
 
+	// pipeline.yaml can be reloaded at runtime (see Run)
+	app.pipelineReloaders = append(app.pipelineReloaders,
+		bootstrap.NewPipelineReloader(cfg.ConfigPath, pipelineManager, processor, pipelineConfig))
+	logrus.Infof("pipeline config version %s", pipelineConfig.Version)
+
+	// ============================================================
+	// Per-namespace pipeline configuration
+	// ============================================================
+	// Events of the namespaces in NAMESPACE_CONFIG_PATHS are evaluated
+	// against their own rules and actions; other namespaces fall back
+	// to pipeline.yaml. Namespace configs reload like pipeline.yaml.
+	// ============================================================
+	for namespace, configPath := range cfg.NamespaceConfigPaths {
+		reloader, err := bootstrap.InitNamespacePipeline(namespace, configPath, pipelineManager, processor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to init pipeline of namespace %s: %w", namespace, err)
+		}
+		app.pipelineReloaders = append(app.pipelineReloaders, reloader)
+	}
+
 	// ============================================================
 	// Step 6: Setup servers
 	// ============================================================
+	app.healthChecker = app.initHealthChecker()
+
 	app.grpcServer = server.NewGRPCServer(cfg.GRPCPort, pipelineManager, cfg.ABNamespace)
+	app.grpcServer.SetHealthChecker(app.healthChecker)
+
+	var adminTokenValidator validator.AuthTokenValidator
+	if cfg.AdminAuthEnabled {
+		adminTokenValidator, err = app.initAdminTokenValidator(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to init admin token validator: %w", err)
+		}
+	}
+	adminHandler := handler.NewAdmin(stateStore, loginTrackingStore, pipelineManager, cfg.ABNamespace)
+	reloaders := make([]handler.PipelineReloader, 0, len(app.pipelineReloaders))
+	for _, reloader := range app.pipelineReloaders {
+		reloaders = append(reloaders, reloader)
+	}
+	adminHandler.SetPipelineReloaders(reloaders...)
+	app.grpcServer.EnableAdmin(adminHandler, adminTokenValidator)
+
 	if err := app.grpcServer.Setup(); err != nil {
 		return nil, fmt.Errorf("failed to setup gRPC server: %w", err)
 	}
 
+	app.gatewayServer = server.NewGatewayServer(cfg.AdminGatewayPort, cfg.GRPCPort)
+	if err := app.gatewayServer.Setup(ctx); err != nil {
+		return nil, fmt.Errorf("failed to setup admin gateway: %w", err)
+	}
+
 	app.metricsServer = server.NewMetricsServer(cfg.MetricsPort, "/metrics")
+	app.metricsServer.SetStatsProvider(pipelineManager)
+	app.metricsServer.SetConfigStatusProvider(app)
+	app.metricsServer.SetHealthChecker(app.healthChecker)
 	if err := app.metricsServer.Setup(); err != nil {
 		return nil, fmt.Errorf("failed to setup metrics server: %w", err)
 	}
@@ -190,7 +297,7 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 // - AB_BASE_URL: AccelByte platform base URL
 // - AB_CLIENT_ID: OAuth2 client ID
 // - AB_CLIENT_SECRET: OAuth2 client secret
-// - AB_NAMESPACE: Game namespace
+// - AB_NAMESPACE: Game namespace, the default namespace of events
 //
 // The SDK uses automatic token refresh (RefreshRate: 0.8 = 80% of TTL).
 //
@@ -220,6 +327,126 @@ func (a *App) initAccelByteSDKAuth() error {
 	return nil
 }
 
+// initAdminTokenValidator creates the validator for admin API access tokens.
+//
+// IMPORTANT: Reuses a.configRepo and a.tokenRepo to share the authenticated
+// session from initAccelByteSDK(). Do NOT create new repository instances.
+func (a *App) initAdminTokenValidator(ctx context.Context) (validator.AuthTokenValidator, error) {
+	oauthService := iam.OAuth20Service{
+		Client:           factory.NewIamClient(a.configRepo),
+		ConfigRepository: a.configRepo,
+		TokenRepository:  a.tokenRepo,
+	}
+
+	tokenValidator := iam.NewTokenValidator(oauthService, adminTokenValidatorRefreshInterval)
+	if err := tokenValidator.Initialize(ctx); err != nil {
+		return nil, err
+	}
+
+	logrus.Info("admin API authentication enabled")
+	return tokenValidator, nil
+}
+
+// initAuditSink creates the decision audit sink selected by AUDIT_SINK.
+// Returns nil when auditing is disabled.
+func (a *App) initAuditSink() (audit.Sink, error) {
+	switch a.cfg.AuditSink {
+	case config.AuditSinkRedis:
+		logrus.Infof("decision audit log enabled: Redis streams with retention %v", a.cfg.AuditRetention)
+		return audit.NewRedisStreamSink(a.redisClient, audit.RedisStreamSinkConfig{Retention: a.cfg.AuditRetention}), nil
+	case config.AuditSinkFile:
+		fileSink, err := audit.NewFileSink(a.cfg.AuditFilePath)
+		if err != nil {
+			return nil, err
+		}
+		a.auditFileSink = fileSink
+		logrus.Infof("decision audit log enabled: appending to %s", a.cfg.AuditFilePath)
+		return fileSink, nil
+	default:
+		logrus.Info("decision audit log disabled")
+		return nil, nil
+	}
+}
+
+// initHealthChecker registers the readiness and liveness checks served on the gRPC
+// health service and on /readyz and /livez of the metrics port.
+//
+// ============================================================
+// DEVELOPER: Health checks
+// ============================================================
+// Readiness checks cover dependencies events cannot be processed
+// without; a failing check takes the pod out of service until it
+// passes again. Liveness checks make Kubernetes restart the pod,
+// so only add checks a restart can fix (e.g. a wedged pipeline),
+// never checks on shared dependencies like Redis.
+//
+// Example:
+// checker.AddReadinessCheck("notification_service", myService.Ping)
+// ============================================================
+func (a *App) initHealthChecker() *health.Checker {
+	checker := health.NewChecker(a.cfg.HealthCheckTimeout)
+
+	checker.AddReadinessCheck("redis", func(ctx context.Context) error {
+		return a.redisClient.Ping(ctx).Err()
+	})
+	checker.AddReadinessCheck("accelbyte_token", a.checkAccelByteToken)
+	checker.AddReadinessCheck("pipeline_config", a.checkPipelineConfig)
+
+	checker.AddLivenessCheck("pipeline_progress", func(ctx context.Context) error {
+		return a.pipelineManager.CheckProgress(a.cfg.PipelineStallTimeout)
+	})
+
+	logrus.Infof("health checks enabled: interval=%v stallTimeout=%v",
+		a.cfg.HealthCheckInterval, a.cfg.PipelineStallTimeout)
+	return checker
+}
+
+// ConfigStatus returns the status of the default and namespace pipeline configurations.
+// A configuration file that fails to reload is reported here, logged, counted by the
+// churn_intervention_pipeline_config_reload_failing metric and fails readiness.
+func (a *App) ConfigStatus() []pipeline.ConfigStatus {
+	statuses := make([]pipeline.ConfigStatus, 0, len(a.pipelineReloaders))
+	for _, reloader := range a.pipelineReloaders {
+		statuses = append(statuses, reloader.Status())
+	}
+	return statuses
+}
+
+// checkPipelineConfig fails when a namespace has no pipeline configuration running, or its
+// configuration file fails to reload. The pipeline then keeps running the previous version,
+// which is not the configuration deployed, so the pod is taken out of service until the
+// file is fixed or reverted.
+func (a *App) checkPipelineConfig(ctx context.Context) error {
+	var errs []error
+	for _, status := range a.ConfigStatus() {
+		name := "default pipeline config"
+		if status.Namespace != "" {
+			name = fmt.Sprintf("pipeline config of namespace %s", status.Namespace)
+		}
+		switch {
+		case status.Version == "":
+			errs = append(errs, fmt.Errorf("no %s loaded", name))
+		case status.ReloadError != "":
+			errs = append(errs, fmt.Errorf("%s fails to reload, running version %s: %s",
+				name, status.Version, status.ReloadError))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkAccelByteToken fails when the SDK holds no access token or the token has expired,
+// i.e. the automatic token refresh has failed and AGS calls will be rejected.
+func (a *App) checkAccelByteToken(ctx context.Context) error {
+	if !repository.HasToken(a.tokenRepo) {
+		return fmt.Errorf("no AccelByte access token")
+	}
+	if untilExpiry := repository.GetSecondsTillExpiry(a.tokenRepo, 1); untilExpiry <= 0 {
+		return fmt.Errorf("AccelByte access token expired %v ago; token refresh is failing",
+			(-untilExpiry).Round(time.Second))
+	}
+	return nil
+}
+
 // initRedis initializes the Redis client.
 func (a *App) initRedis(ctx context.Context) error {
 	client := redis.NewClient(&redis.Options{
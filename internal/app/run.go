@@ -9,12 +9,19 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
+// shutdownTimeout bounds how long graceful shutdown may take to drain in-flight work.
+const shutdownTimeout = 30 * time.Second
+
 // Run starts the application and blocks until a shutdown signal is received.
 func (a *App) Run(ctx context.Context) error {
+	// Check health before serving, so probes see real status from the start
+	a.healthChecker.CheckNow(ctx)
+
 	// Start servers
 	if err := a.grpcServer.Start(ctx); err != nil {
 		return err
@@ -22,16 +29,54 @@ func (a *App) Run(ctx context.Context) error {
 	if err := a.metricsServer.Start(ctx); err != nil {
 		return err
 	}
+	if err := a.gatewayServer.Start(ctx); err != nil {
+		return err
+	}
 
 	logrus.Info("application started successfully")
 
 	// Wait for shutdown signal
-	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	signalCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
-	<-ctx.Done()
+
+	go a.reloadPipelineConfig(signalCtx)
+	go a.healthChecker.Run(signalCtx, a.cfg.HealthCheckInterval)
+
+	<-signalCtx.Done()
 
 	logrus.Info("shutdown signal received")
-	return a.Shutdown(ctx)
+
+	// The signal context is already cancelled; give shutdown its own deadline
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return a.Shutdown(shutdownCtx)
+}
+
+// reloadPipelineConfig reloads pipeline.yaml, and the pipeline configs of namespaces, on
+// SIGHUP and, unless CONFIG_WATCH_INTERVAL is 0, whenever a file changes. It returns when
+// ctx is cancelled.
+func (a *App) reloadPipelineConfig(ctx context.Context) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	if a.cfg.ConfigWatchInterval > 0 {
+		for _, reloader := range a.pipelineReloaders {
+			go reloader.Watch(ctx, a.cfg.ConfigWatchInterval)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			logrus.Info("SIGHUP received, reloading pipeline config")
+			for _, reloader := range a.pipelineReloaders {
+				_ = reloader.Reload() // Failures are logged by the reloader
+			}
+		}
+	}
 }
 
 // Shutdown gracefully shuts down all application components.
@@ -40,9 +85,10 @@ func (a *App) Run(ctx context.Context) error {
 // DEVELOPER: Shutdown order is critical
 // ============================================================
 // Components are shut down in reverse dependency order:
-// 1. Stop accepting new requests (gRPC + metrics servers)
-// 2. Close external connections (Redis, databases)
-// 3. Flush telemetry data (OpenTelemetry)
+// 1. Stop accepting new requests (gRPC, metrics and admin gateway servers)
+// 2. Drain in-flight pipeline work (queued events, then async actions)
+// 3. Close external connections (Redis, databases)
+// 4. Flush telemetry data (OpenTelemetry)
 //
 // If you added custom services in app.New(), shut them down here
 // following this order. For example, if you added a database
@@ -57,6 +103,9 @@ func (a *App) Shutdown(ctx context.Context) error {
 	// ============================================================
 	// Step 1: Shutdown servers (stop accepting new requests)
 	// ============================================================
+	if err := a.gatewayServer.Shutdown(ctx); err != nil {
+		logrus.Errorf("admin gateway shutdown error: %v", err)
+	}
 	if err := a.grpcServer.Shutdown(ctx); err != nil {
 		logrus.Errorf("gRPC server shutdown error: %v", err)
 	}
@@ -65,7 +114,16 @@ func (a *App) Shutdown(ctx context.Context) error {
 	}
 
 	// ============================================================
-	// Step 2: Close external connections
+	// Step 2: Drain in-flight pipeline work
+	// ============================================================
+	if a.pipelineManager != nil {
+		if err := a.pipelineManager.Shutdown(ctx); err != nil {
+			logrus.Errorf("pipeline shutdown error: %v", err)
+		}
+	}
+
+	// ============================================================
+	// Step 3: Close external connections
 	// ============================================================
 	// DEVELOPER: Add custom service cleanup here
 	// Example:
@@ -80,9 +138,14 @@ func (a *App) Shutdown(ctx context.Context) error {
 			logrus.Errorf("Redis close error: %v", err)
 		}
 	}
+	if a.auditFileSink != nil {
+		if err := a.auditFileSink.Close(); err != nil {
+			logrus.Errorf("audit log close error: %v", err)
+		}
+	}
 
 	// ============================================================
-	// Step 3: Flush telemetry data
+	// Step 4: Flush telemetry data
 	// ============================================================
 	if a.shutdownTelemetry != nil {
 		if err := a.shutdownTelemetry(ctx); err != nil {
@@ -49,21 +49,32 @@ func InitActionExecutor(
 	// ============================================================
 	actionBuiltin.RegisterActions(deps)
 
+	registry, err := buildActionRegistry(pipelineConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executor := action.NewExecutor(registry)
+	logrus.Infof("initialized action executor")
+
+	return executor, registry, nil
+}
+
+// buildActionRegistry creates the actions defined in pipeline config.
+// Action types must already be registered.
+func buildActionRegistry(pipelineConfig *pipeline.Config) (*action.Registry, error) {
 	// Convert pipeline configs to action configs
 	actionConfigs := convertActionConfigs(pipelineConfig.Actions)
 
 	// Create registry and register actions
 	registry := action.NewRegistry()
 	if err := action.RegisterActions(registry, actionConfigs); err != nil {
-		return nil, nil, fmt.Errorf("failed to register actions: %w", err)
+		return nil, fmt.Errorf("failed to register actions: %w", err)
 	}
 
 	logrus.Infof("registered %d actions", len(actionConfigs))
 
-	executor := action.NewExecutor(registry)
-	logrus.Infof("initialized action executor")
-
-	return executor, registry, nil
+	return registry, nil
 }
 
 func convertActionConfigs(configs []pipeline.ActionConfig) []action.ActionConfig {
@@ -73,8 +84,22 @@ func convertActionConfigs(configs []pipeline.ActionConfig) []action.ActionConfig
 			ID:         ac.ID,
 			Type:       ac.Type,
 			Enabled:    ac.Enabled,
+			Mode:       ac.Mode,
+			Async:      ac.Async,
+			Retry:      convertRetryConfig(ac.Retry),
 			Parameters: ac.Parameters,
 		}
 	}
 	return result
 }
+
+func convertRetryConfig(rc *pipeline.RetryConfig) *action.RetryConfig {
+	if rc == nil {
+		return nil
+	}
+	return &action.RetryConfig{
+		MaxAttempts: rc.MaxAttempts,
+		Delay:       rc.Delay,
+		Backoff:     rc.Backoff,
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	actionBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/action/builtin"
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+)
+
+// OfflineServices stands in for AGS in offline commands (replay, simulate, lint, schema).
+// It implements the AGS-backed services used by built-in actions; calls succeed
+// without doing anything and are reported to OnCall when it is set.
+//
+// ============================================================
+// DEVELOPER: If you add AGS-backed services to actionBuiltin.Dependencies,
+// implement them here too so offline commands can run your actions.
+// ============================================================
+type OfflineServices struct {
+	OnCall func(call string)
+}
+
+// GrantEntitlement implements service.EntitlementGranter.
+func (s *OfflineServices) GrantEntitlement(ctx context.Context, userID, itemID string, quantity int) error {
+	s.report("grant %d x item %s to user %s", quantity, itemID, userID)
+	return nil
+}
+
+// UpdateStatComebackChallenge implements service.UserStatisticUpdater.
+func (s *OfflineServices) UpdateStatComebackChallenge(ctx context.Context, userID string) error {
+	s.report("update stat rse-comeback-challenge of user %s", userID)
+	return nil
+}
+
+func (s *OfflineServices) report(format string, args ...interface{}) {
+	if s.OnCall != nil {
+		s.OnCall(fmt.Sprintf(format, args...))
+	}
+}
+
+// InitOfflinePipeline builds the pipeline from pipelineConfig for offline commands:
+// state is kept in memory, AGS is replaced by services and time is read from clk.
+func InitOfflinePipeline(pipelineConfig *pipeline.Config, namespace string, services *OfflineServices, clk clock.Clock) (*pipeline.Manager, error) {
+	stateStore := service.NewMemoryChurnStateStore()
+	loginTrackingStore := service.NewMemoryLoginSessionTrackingStore(clk)
+
+	processor := InitSignalProcessor(stateStore, loginTrackingStore, service.NewMemoryStatCycleStore(),
+		service.NewMemorySessionTracker(), namespace, clk)
+	if err := RegisterSignalMappings(processor, pipelineConfig); err != nil {
+		return nil, fmt.Errorf("failed to register signal mappings: %w", err)
+	}
+
+	ruleEngine, ruleRegistry, err := InitRuleEngine(pipelineConfig, loginTrackingStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init rule engine: %w", err)
+	}
+	ruleEngine.SetClock(clk)
+
+	deps := &actionBuiltin.Dependencies{
+		StateStore:         stateStore,
+		EntitlementGranter: services,
+		UserStatUpdater:    services,
+		Namespace:          namespace,
+	}
+	actionExecutor, actionRegistry, err := InitActionExecutor(pipelineConfig, deps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init action executor: %w", err)
+	}
+
+	if err := pipeline.ValidateWiring(ruleRegistry, actionRegistry, pipelineConfig); err != nil {
+		return nil, fmt.Errorf("pipeline wiring validation failed: %w", err)
+	}
+
+	manager := InitPipeline(processor, ruleEngine, actionExecutor, pipelineConfig)
+	manager.SetClock(clk)
+	return manager, nil
+}
+
+// RegisterOfflineTypes registers the rule and action types, including custom ones, with
+// in-memory state and no-op AGS services, for offline commands that create rules and
+// actions without running them (lint, schema).
+func RegisterOfflineTypes(namespace string) error {
+	empty := &pipeline.Config{}
+
+	if _, _, err := InitRuleEngine(empty, service.NewMemoryLoginSessionTrackingStore(nil)); err != nil {
+		return fmt.Errorf("failed to register rule types: %w", err)
+	}
+
+	deps := &actionBuiltin.Dependencies{
+		StateStore:         service.NewMemoryChurnStateStore(),
+		EntitlementGranter: &OfflineServices{},
+		UserStatUpdater:    &OfflineServices{},
+		Namespace:          namespace,
+	}
+	if _, _, err := InitActionExecutor(empty, deps); err != nil {
+		return fmt.Errorf("failed to register action types: %w", err)
+	}
+
+	return nil
+}
+
+// InitLintFactories registers the rule and action types (see RegisterOfflineTypes) and
+// returns factories that create signal mappings, rules and actions from config entries
+// for pipeline.Lint. Disabled entries are created too, so their types and parameters are
+// checked before they are enabled.
+func InitLintFactories(namespace string) (pipeline.LintFactories, error) {
+	if err := RegisterOfflineTypes(namespace); err != nil {
+		return pipeline.LintFactories{}, err
+	}
+
+	processor := InitSignalProcessor(service.NewMemoryChurnStateStore(), service.NewMemoryLoginSessionTrackingStore(nil),
+		service.NewMemoryStatCycleStore(), service.NewMemorySessionTracker(), namespace, clock.Real{})
+
+	return pipeline.LintFactories{
+		CreateSignal: func(config pipeline.SignalConfig) error {
+			return checkSignalMapping(processor.GetEventProcessorRegistry(), config)
+		},
+		CreateRule: func(config pipeline.RuleConfig) error {
+			config.Enabled = true
+			_, err := rule.CreateRule(convertRuleConfigs([]pipeline.RuleConfig{config})[0])
+			return err
+		},
+		CreateAction: func(config pipeline.ActionConfig) error {
+			config.Enabled = true
+			_, err := action.CreateAction(convertActionConfigs([]pipeline.ActionConfig{config})[0])
+			return err
+		},
+	}, nil
+}
@@ -5,6 +5,8 @@
 package bootstrap
 
 import (
+	"fmt"
+
 	"github.com/AccelByte/extend-churn-intervention/pkg/action"
 	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
@@ -40,12 +42,17 @@ func InitPipeline(
 	actionExecutor *action.Executor,
 	pipelineConfig *pipeline.Config,
 ) *pipeline.Manager {
-	// ============================================================
-	// Build rule-to-actions mapping from config
-	// ============================================================
-	// This extracts the 'actions' field from each rule in
-	// config/pipeline.yaml and creates a map for quick lookup.
-	// ============================================================
+	ruleActions := buildRuleActions(pipelineConfig)
+
+	manager := pipeline.NewManager(processor, ruleEngine, actionExecutor, ruleActions, nil)
+	logrus.Infof("initialized pipeline manager")
+
+	return manager
+}
+
+// buildRuleActions extracts the 'actions' field from each rule in
+// config/pipeline.yaml and creates a rule ID to action IDs map for quick lookup.
+func buildRuleActions(pipelineConfig *pipeline.Config) map[string][]string {
 	ruleActions := make(map[string][]string)
 	for _, rc := range pipelineConfig.Rules {
 		if len(rc.Actions) > 0 {
@@ -55,8 +62,55 @@ func InitPipeline(
 
 	logrus.Infof("configured %d rule-to-action mappings", len(ruleActions))
 
-	manager := pipeline.NewManager(processor, ruleEngine, actionExecutor, ruleActions, nil)
-	logrus.Infof("initialized pipeline manager")
+	return ruleActions
+}
 
-	return manager
+// InitNamespacePipeline loads the pipeline config of a namespace from configPath into the
+// manager, so that events of the namespace are evaluated against its own rules and actions
+// instead of those of the default config. Rule and action types must already be registered
+// (see InitRuleEngine and InitActionExecutor). Returns the reloader of the config.
+//
+// Signal mappings are shared by all namespaces, so a namespace config must not have any.
+func InitNamespacePipeline(
+	namespace string,
+	configPath string,
+	manager *pipeline.Manager,
+	processor *signal.Processor,
+) (*PipelineReloader, error) {
+	pipelineConfig, err := pipeline.LoadConfig(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pipeline config from %s: %w", configPath, err)
+	}
+
+	if err := checkNamespaceConfig(pipelineConfig); err != nil {
+		return nil, fmt.Errorf("pipeline config %s: %w", configPath, err)
+	}
+
+	ruleRegistry, err := buildRuleRegistry(pipelineConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	actionRegistry, err := buildActionRegistry(pipelineConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pipeline.ValidateWiring(ruleRegistry, actionRegistry, pipelineConfig); err != nil {
+		return nil, err
+	}
+
+	manager.ReloadNamespace(namespace, ruleRegistry, actionRegistry, buildRuleActions(pipelineConfig))
+	logrus.Infof("loaded pipeline configuration of namespace %s from %s (version %s)", namespace, configPath, pipelineConfig.Version)
+
+	return newPipelineReloader(namespace, configPath, manager, processor, pipelineConfig), nil
+}
+
+// checkNamespaceConfig rejects signal mappings in the pipeline config of a namespace:
+// mappings route stat codes for all namespaces and are read from the default config.
+func checkNamespaceConfig(pipelineConfig *pipeline.Config) error {
+	if len(pipelineConfig.Signals) > 0 {
+		return fmt.Errorf("signal mappings are shared by all namespaces, define them in the default pipeline config")
+	}
+	return nil
 }
@@ -0,0 +1,242 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// PipelineReloader reloads config/pipeline.yaml into a running pipeline manager.
+//
+// ============================================================
+// DEVELOPER: Hot reload of pipeline.yaml
+// ============================================================
+// A reload re-reads the file, rebuilds the rule and action
+// registries from the rule and action types registered at
+// startup, and runs ValidateWiring. Only when all of this
+// succeeds are the new signal mappings, rules, actions and
+// rule-to-action mappings swapped in; otherwise the running
+// configuration is kept and the error is logged.
+//
+// Reloads are triggered by Watch (file changes) or by calling
+// Reload directly (e.g. on SIGHUP or through the admin API). New rule or action TYPES
+// still require a redeploy; only their configuration reloads.
+//
+// Each namespace with its own pipeline config (see
+// InitNamespacePipeline) has its own reloader.
+// ============================================================
+type PipelineReloader struct {
+	configPath string
+	manager    *pipeline.Manager
+	processor  *signal.Processor
+	namespace  string // Namespace of a namespace config, "" for the default config
+	log        *logrus.Entry
+
+	mu            sync.Mutex
+	current       *pipeline.Config
+	failedVersion string // Last version that failed to load, to avoid retrying it on every poll
+	failedErr     error  // Why failedVersion failed to load, nil once the file loads again
+}
+
+// NewPipelineReloader creates a reloader for a manager and signal processor built from
+// current, which was loaded from configPath.
+func NewPipelineReloader(configPath string, manager *pipeline.Manager, processor *signal.Processor, current *pipeline.Config) *PipelineReloader {
+	return newPipelineReloader("", configPath, manager, processor, current)
+}
+
+func newPipelineReloader(namespace, configPath string, manager *pipeline.Manager, processor *signal.Processor, current *pipeline.Config) *PipelineReloader {
+	r := &PipelineReloader{
+		configPath: configPath,
+		manager:    manager,
+		processor:  processor,
+		namespace:  namespace,
+		current:    current,
+	}
+	r.log = logrus.WithField("namespace", r.metricNamespace())
+	r.setConfigVersionMetric(current.Version)
+	metrics.PipelineConfigReloadFailing.WithLabelValues(r.metricNamespace()).Set(0)
+	return r
+}
+
+// Namespace returns the namespace of the configuration, "" for the default configuration.
+func (r *PipelineReloader) Namespace() string {
+	return r.namespace
+}
+
+// Version returns the version of the running configuration.
+func (r *PipelineReloader) Version() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.current.Version
+}
+
+// Reload loads the configuration file and, if it is valid and differs from the
+// running configuration, swaps it into the pipeline manager.
+func (r *PipelineReloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.reload()
+}
+
+func (r *PipelineReloader) reload() error {
+	data, err := os.ReadFile(r.configPath)
+	if err != nil {
+		return r.fail("", fmt.Errorf("failed to read pipeline config %s: %w", r.configPath, err))
+	}
+
+	next, err := pipeline.ParseConfig(data)
+	if err != nil {
+		return r.fail(pipeline.ConfigVersion(data), fmt.Errorf("failed to load pipeline config from %s: %w", r.configPath, err))
+	}
+
+	if next.Version == r.current.Version {
+		r.log.Infof("pipeline config %s unchanged, skipping reload", next.Version)
+		r.clearFailure()
+		return nil
+	}
+
+	ruleRegistry, err := buildRuleRegistry(next)
+	if err != nil {
+		return r.fail(next.Version, err)
+	}
+
+	actionRegistry, err := buildActionRegistry(next)
+	if err != nil {
+		return r.fail(next.Version, err)
+	}
+
+	if err := pipeline.ValidateWiring(ruleRegistry, actionRegistry, next); err != nil {
+		return r.fail(next.Version, err)
+	}
+
+	if r.namespace != "" {
+		if err := checkNamespaceConfig(next); err != nil {
+			return r.fail(next.Version, err)
+		}
+	} else if err := checkSignalMappings(r.processor, next); err != nil {
+		return r.fail(next.Version, err)
+	}
+
+	changes := pipeline.DiffConfig(r.current, next)
+	if r.namespace != "" {
+		r.manager.ReloadNamespace(r.namespace, ruleRegistry, actionRegistry, buildRuleActions(next))
+	} else {
+		replaceSignalMappings(r.processor, r.current, next)
+		r.manager.Reload(ruleRegistry, actionRegistry, buildRuleActions(next))
+	}
+
+	r.log.Infof("pipeline config reloaded: version %s -> %s (%d changes)", r.current.Version, next.Version, len(changes))
+	for _, change := range changes {
+		r.log.Infof("pipeline config change: %s", change)
+	}
+
+	r.current = next
+	r.clearFailure()
+	r.setConfigVersionMetric(next.Version)
+	metrics.PipelineConfigReloadsTotal.WithLabelValues(r.metricNamespace(), "success").Inc()
+
+	return nil
+}
+
+// fail records a failed reload. The running configuration is left untouched.
+func (r *PipelineReloader) fail(version string, err error) error {
+	r.failedVersion = version
+	r.failedErr = err
+	metrics.PipelineConfigReloadsTotal.WithLabelValues(r.metricNamespace(), "failure").Inc()
+	metrics.PipelineConfigReloadFailing.WithLabelValues(r.metricNamespace()).Set(1)
+	r.log.Errorf("pipeline config reload failed, keeping version %s: %v", r.current.Version, err)
+	return err
+}
+
+func (r *PipelineReloader) clearFailure() {
+	r.failedVersion = ""
+	r.failedErr = nil
+	metrics.PipelineConfigReloadFailing.WithLabelValues(r.metricNamespace()).Set(0)
+}
+
+// Status returns the running configuration version and, while the configuration file
+// does not load, why. The pipeline then keeps serving the running version, which
+// differs from the one deployed, until the file is fixed or reverted.
+func (r *PipelineReloader) Status() pipeline.ConfigStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status := pipeline.ConfigStatus{Namespace: r.namespace, Version: r.current.Version}
+	if r.failedErr != nil {
+		status.ReloadError = r.failedErr.Error()
+	}
+	return status
+}
+
+// Watch polls the configuration file every interval and reloads it when its
+// contents change. Polling (rather than inotify) also picks up Kubernetes
+// ConfigMap updates, which replace the mounted file through a symlink swap.
+// Watch blocks until ctx is cancelled.
+func (r *PipelineReloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	r.log.Infof("watching pipeline config %s for changes every %v", r.configPath, interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reloadIfChanged()
+		}
+	}
+}
+
+// reloadIfChanged reloads the configuration if the file contents differ from both
+// the running configuration and the last version that failed to load.
+func (r *PipelineReloader) reloadIfChanged() {
+	data, err := os.ReadFile(r.configPath)
+	if err != nil {
+		r.log.Warnf("failed to read pipeline config %s: %v", r.configPath, err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	version := pipeline.ConfigVersion(data)
+	if version == r.current.Version {
+		r.clearFailure() // The file was reverted to the running configuration
+		return
+	}
+	if version == r.failedVersion {
+		return
+	}
+
+	r.log.Infof("pipeline config %s changed, reloading", r.configPath)
+	_ = r.reload() // Failures are logged and counted by reload
+}
+
+// metricNamespace returns the namespace label of the reloader's metrics.
+func (r *PipelineReloader) metricNamespace() string {
+	if r.namespace != "" {
+		return r.namespace
+	}
+	return r.processor.GetNamespace()
+}
+
+// setConfigVersionMetric labels the config info metric of the namespace with the active version.
+func (r *PipelineReloader) setConfigVersionMetric(version string) {
+	metrics.PipelineConfigInfo.DeletePartialMatch(prometheus.Labels{"namespace": r.metricNamespace()})
+	metrics.PipelineConfigInfo.WithLabelValues(r.metricNamespace(), version).Set(1)
+}
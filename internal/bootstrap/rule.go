@@ -69,21 +69,32 @@ func InitRuleEngine(
 	// })
 	// ============================================================
 
+	registry, err := buildRuleRegistry(pipelineConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	engine := rule.NewEngine(registry)
+	logrus.Infof("initialized rule engine")
+
+	return engine, registry, nil
+}
+
+// buildRuleRegistry creates the rules defined in pipeline config.
+// Rule types must already be registered.
+func buildRuleRegistry(pipelineConfig *pipeline.Config) (*rule.Registry, error) {
 	// Convert pipeline configs to rule configs
 	ruleConfigs := convertRuleConfigs(pipelineConfig.Rules)
 
 	// Create registry and register rules
 	registry := rule.NewRegistry()
 	if err := rule.RegisterRules(registry, ruleConfigs); err != nil {
-		return nil, nil, fmt.Errorf("failed to register rules: %w", err)
+		return nil, fmt.Errorf("failed to register rules: %w", err)
 	}
 
 	logrus.Infof("registered %d rules", len(ruleConfigs))
 
-	engine := rule.NewEngine(registry)
-	logrus.Infof("initialized rule engine")
-
-	return engine, registry, nil
+	return registry, nil
 }
 
 func convertRuleConfigs(configs []pipeline.RuleConfig) []rule.RuleConfig {
@@ -93,8 +104,19 @@ func convertRuleConfigs(configs []pipeline.RuleConfig) []rule.RuleConfig {
 			ID:         rc.ID,
 			Type:       rc.Type,
 			Enabled:    rc.Enabled,
+			Mode:       rc.Mode,
+			Cooldown:   convertCooldownConfig(rc.Cooldown),
+			Conditions: rc.Conditions,
 			Parameters: rc.Parameters,
 		}
 	}
 	return result
 }
+
+func convertCooldownConfig(cc *pipeline.CooldownConfig) *rule.CooldownConfig {
+	if cc == nil {
+		return nil
+	}
+	cooldown := *cc
+	return &cooldown
+}
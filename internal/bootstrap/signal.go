@@ -5,6 +5,10 @@
 package bootstrap
 
 import (
+	"fmt"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
@@ -12,6 +16,7 @@ import (
 )
 
 // InitSignalProcessor creates and initializes a signal processor with builtin event processors.
+// clk stands in for the time of events without a timestamp.
 //
 // ============================================================
 // DEVELOPER: Register custom event processors here.
@@ -27,16 +32,21 @@ import (
 // 4. The registration function is called below automatically
 //
 // The builtin processors handle:
-// - OAuth login events → login signals
+// - OAuth login and token revocation events → login and session ended signals
 // - Stat updates (match wins, losses, streaks) → game signals
+// - Stat lifecycle (created, deleted, cycle resets) → stat signals and resets
 // - Custom stat codes → custom signals
 // ============================================================
 func InitSignalProcessor(
 	stateStore service.StateStore,
 	loginTrackingStore service.LoginSessionTracker,
+	statCycleStore service.StatCycleStore,
+	sessionTracker service.SessionTracker,
 	namespace string,
+	clk clock.Clock,
 ) *signal.Processor {
 	processor := signal.NewProcessor(stateStore, namespace)
+	processor.SetClock(clk)
 
 	// ============================================================
 	// DEVELOPER: Builtin event processor registration
@@ -57,6 +67,9 @@ func InitSignalProcessor(
 		// ============================================================
 		&signalBuiltin.EventProcessorDependencies{
 			LoginTrackingStore: loginTrackingStore,
+			StatCycleStore:     statCycleStore,
+			SessionTracker:     sessionTracker,
+			Clock:              processor.GetClock(),
 		},
 	)
 
@@ -75,3 +88,77 @@ func InitSignalProcessor(
 
 	return processor
 }
+
+// RegisterSignalMappings registers an event processor for each entry of the signals
+// section of pipeline.yaml, turning updates of its stat code into signals of its type.
+//
+// ============================================================
+// DEVELOPER: Stat-to-signal mappings
+// ============================================================
+// A new game stat usually needs no Go code: map it to a signal
+// type in the signals section of config/pipeline.yaml and
+// evaluate it with the threshold rule (or a custom rule whose
+// SignalTypes returns the mapped type). Write an EventProcessor
+// only when a signal needs more than the stat value and
+// additional_data fields.
+// ============================================================
+func RegisterSignalMappings(processor *signal.Processor, pipelineConfig *pipeline.Config) error {
+	if err := checkSignalMappings(processor, pipelineConfig); err != nil {
+		return err
+	}
+
+	replaceSignalMappings(processor, &pipeline.Config{}, pipelineConfig)
+
+	if len(pipelineConfig.Signals) > 0 {
+		logrus.Infof("registered %d stat-to-signal mappings", len(pipelineConfig.Signals))
+	}
+	return nil
+}
+
+// replaceSignalMappings swaps the signal mappings of previous for those of next.
+// New mappings are registered before stale ones are removed, so a stat code mapped by
+// both configurations is never unhandled.
+func replaceSignalMappings(processor *signal.Processor, previous, next *pipeline.Config) {
+	registry := processor.GetEventProcessorRegistry()
+
+	mapped := make(map[string]bool, len(next.Signals))
+	for _, sc := range next.Signals {
+		registry.Register(signal.NewStatEventProcessor(sc.StatMapping(), processor.GetStateStore(), processor.GetNamespace(), processor.GetClock()))
+		mapped[sc.StatCode] = true
+	}
+
+	for _, sc := range previous.Signals {
+		if !mapped[sc.StatCode] {
+			_ = registry.Unregister(sc.StatCode) // Only fails if already unregistered
+		}
+	}
+}
+
+// checkSignalMappings checks that the signal mappings of pipelineConfig can be registered.
+func checkSignalMappings(processor *signal.Processor, pipelineConfig *pipeline.Config) error {
+	for _, sc := range pipelineConfig.Signals {
+		if err := checkSignalMapping(processor.GetEventProcessorRegistry(), sc); err != nil {
+			return fmt.Errorf("signal %s: %w", sc.StatCode, err)
+		}
+	}
+	return nil
+}
+
+// checkSignalMapping rejects a mapping that would replace an event processor written in
+// Go, or emit a signal type whose rules expect a different signal struct.
+func checkSignalMapping(registry *signal.EventProcessorRegistry, sc pipeline.SignalConfig) error {
+	if existing := registry.Get(sc.StatCode); existing != nil {
+		if _, mapped := existing.(*signal.StatEventProcessor); !mapped {
+			return fmt.Errorf("stat code %s is already handled by event processor %T", sc.StatCode, existing)
+		}
+	}
+
+	reserved := append(signalBuiltin.SignalTypes(), signal.TypeStatUpdate)
+	for _, signalType := range reserved {
+		if sc.Type == signalType {
+			return fmt.Errorf("signal type %s is reserved for built-in signals", sc.Type)
+		}
+	}
+
+	return nil
+}
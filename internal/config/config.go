@@ -4,6 +4,15 @@
 
 package config
 
+import "time"
+
+// Decision audit sinks selectable with AUDIT_SINK.
+const (
+	AuditSinkRedis = "redis"
+	AuditSinkFile  = "file"
+	AuditSinkNone  = "none"
+)
+
 // Config holds all application configuration loaded from environment variables.
 // This struct uses github.com/caarlos0/env for automatic environment variable parsing.
 //
@@ -51,7 +60,95 @@ type Config struct {
 	// ============================================================
 	// Pipeline configuration
 	// ============================================================
-	ConfigPath string `env:"CONFIG_PATH" envDefault:"config/pipeline.yaml"`
+	// CONFIG_PATH is reloaded on SIGHUP and, unless
+	// CONFIG_WATCH_INTERVAL is 0, polled for changes.
+	ConfigPath          string        `env:"CONFIG_PATH" envDefault:"config/pipeline.yaml"`
+	ConfigWatchInterval time.Duration `env:"CONFIG_WATCH_INTERVAL" envDefault:"30s"`
+
+	// Events are processed in the namespace they carry. Events of the
+	// namespaces in NAMESPACE_CONFIG_PATHS ("ns=path,ns=path") are
+	// evaluated against the rules and actions of their own pipeline
+	// config; all other namespaces use CONFIG_PATH. Signal mappings
+	// are shared and only read from CONFIG_PATH.
+	NamespaceConfigPaths map[string]string `env:"NAMESPACE_CONFIG_PATHS" envKeyValSeparator:"="`
+
+	// ============================================================
+	// Event deduplication configuration
+	// ============================================================
+	// Kafka Connect may redeliver events; processed event IDs are
+	// remembered in Redis for EVENT_DEDUP_TTL and duplicates are dropped.
+	// An event is processed under a lease of EVENT_DEDUP_LEASE; duplicates
+	// arriving meanwhile fail, so that they are redelivered and processed
+	// if the event fails or its processing is interrupted.
+	EventDedupEnabled bool          `env:"EVENT_DEDUP_ENABLED" envDefault:"true"`
+	EventDedupTTL     time.Duration `env:"EVENT_DEDUP_TTL" envDefault:"24h"`
+	EventDedupLease   time.Duration `env:"EVENT_DEDUP_LEASE" envDefault:"5m"`
+
+	// ============================================================
+	// Event time configuration
+	// ============================================================
+	// Signals are stamped, and logins bucketed into weeks, by the
+	// AGS event timestamp. Events that occurred longer than
+	// EVENT_LATENESS_WINDOW ago, e.g. when Kafka Connect lags or
+	// replays, are dropped and counted; 0, the default, accepts all
+	// events, however late. When set, keep it within EVENT_DEDUP_TTL
+	// so late redeliveries cannot count twice.
+	EventLatenessWindow time.Duration `env:"EVENT_LATENESS_WINDOW" envDefault:"0"`
+
+	// ============================================================
+	// Pipeline lane configuration
+	// ============================================================
+	// Events are sharded by user ID onto PIPELINE_LANE_SHARDS worker
+	// lanes so events for one player are processed in order. Each
+	// lane buffers up to PIPELINE_LANE_QUEUE_DEPTH pending events.
+	PipelineLaneShards     int `env:"PIPELINE_LANE_SHARDS" envDefault:"16"`
+	PipelineLaneQueueDepth int `env:"PIPELINE_LANE_QUEUE_DEPTH" envDefault:"100"`
+
+	// ============================================================
+	// Async action configuration
+	// ============================================================
+	// Actions with `async: true` in pipeline.yaml are executed by
+	// ASYNC_ACTION_WORKERS workers off the request path. Up to
+	// ASYNC_ACTION_QUEUE_SIZE actions may wait; beyond that they
+	// run inline.
+	AsyncActionWorkers   int `env:"ASYNC_ACTION_WORKERS" envDefault:"4"`
+	AsyncActionQueueSize int `env:"ASYNC_ACTION_QUEUE_SIZE" envDefault:"1000"`
+
+	// ============================================================
+	// Decision audit log configuration
+	// ============================================================
+	// Every processed signal is recorded with the decision of each
+	// rule and the result of each action. AUDIT_SINK is "redis"
+	// (the default; a stream per player, kept for
+	// AUDIT_RETENTION), "none" or "file" (JSON lines appended to
+	// AUDIT_FILE_PATH, for local development). Records are
+	// written as each event is processed, so enabling a sink adds
+	// a write per signal.
+	AuditSink      string        `env:"AUDIT_SINK" envDefault:"redis"`
+	AuditRetention time.Duration `env:"AUDIT_RETENTION" envDefault:"720h"`
+	AuditFilePath  string        `env:"AUDIT_FILE_PATH" envDefault:"audit.jsonl"`
+
+	// ============================================================
+	// Admin API configuration
+	// ============================================================
+	// The admin API is served on GRPC_PORT and as HTTP/JSON on
+	// ADMIN_GATEWAY_PORT. Calls require an AccelByte IAM token with
+	// the ADMIN:NAMESPACE:{namespace}:CHURN permission unless
+	// ADMIN_AUTH_ENABLED is false (local development only).
+	AdminGatewayPort int  `env:"ADMIN_GATEWAY_PORT" envDefault:"8000"`
+	AdminAuthEnabled bool `env:"ADMIN_AUTH_ENABLED" envDefault:"true"`
+
+	// ============================================================
+	// Health check configuration
+	// ============================================================
+	// Readiness (Redis, AGS token, pipeline config) and liveness
+	// (pipeline progress) are checked every HEALTH_CHECK_INTERVAL,
+	// each check bounded by HEALTH_CHECK_TIMEOUT. The pipeline is
+	// considered wedged when a lane has pending events but none
+	// completed for PIPELINE_STALL_TIMEOUT.
+	HealthCheckInterval  time.Duration `env:"HEALTH_CHECK_INTERVAL" envDefault:"10s"`
+	HealthCheckTimeout   time.Duration `env:"HEALTH_CHECK_TIMEOUT" envDefault:"5s"`
+	PipelineStallTimeout time.Duration `env:"PIPELINE_STALL_TIMEOUT" envDefault:"2m"`
 
 	// ============================================================
 	// Telemetry configuration
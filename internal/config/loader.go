@@ -59,11 +59,82 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid METRICS_PORT: %d (must be 1-65535)", c.MetricsPort)
 	}
 
+	if c.AdminGatewayPort < 1 || c.AdminGatewayPort > 65535 {
+		return fmt.Errorf("invalid ADMIN_GATEWAY_PORT: %d (must be 1-65535)", c.AdminGatewayPort)
+	}
+
 	// Validate required fields
 	if c.ABNamespace == "" {
 		return fmt.Errorf("AB_NAMESPACE is required")
 	}
 
+	if c.ConfigWatchInterval < 0 {
+		return fmt.Errorf("invalid CONFIG_WATCH_INTERVAL: %v (must not be negative)", c.ConfigWatchInterval)
+	}
+
+	for namespace, path := range c.NamespaceConfigPaths {
+		if namespace == "" || path == "" {
+			return fmt.Errorf("invalid NAMESPACE_CONFIG_PATHS: %q=%q (namespace and path are required)", namespace, path)
+		}
+		if namespace == c.ABNamespace {
+			return fmt.Errorf("invalid NAMESPACE_CONFIG_PATHS: %s is AB_NAMESPACE, whose config is CONFIG_PATH", namespace)
+		}
+	}
+
+	if c.EventDedupEnabled && c.EventDedupTTL <= 0 {
+		return fmt.Errorf("invalid EVENT_DEDUP_TTL: %v (must be positive)", c.EventDedupTTL)
+	}
+
+	if c.EventDedupEnabled && c.EventDedupLease <= 0 {
+		return fmt.Errorf("invalid EVENT_DEDUP_LEASE: %v (must be positive)", c.EventDedupLease)
+	}
+
+	if c.EventLatenessWindow < 0 {
+		return fmt.Errorf("invalid EVENT_LATENESS_WINDOW: %v (must not be negative)", c.EventLatenessWindow)
+	}
+
+	if c.PipelineLaneShards < 1 {
+		return fmt.Errorf("invalid PIPELINE_LANE_SHARDS: %d (must be at least 1)", c.PipelineLaneShards)
+	}
+
+	if c.PipelineLaneQueueDepth < 1 {
+		return fmt.Errorf("invalid PIPELINE_LANE_QUEUE_DEPTH: %d (must be at least 1)", c.PipelineLaneQueueDepth)
+	}
+
+	if c.AsyncActionWorkers < 1 {
+		return fmt.Errorf("invalid ASYNC_ACTION_WORKERS: %d (must be at least 1)", c.AsyncActionWorkers)
+	}
+
+	if c.AsyncActionQueueSize < 1 {
+		return fmt.Errorf("invalid ASYNC_ACTION_QUEUE_SIZE: %d (must be at least 1)", c.AsyncActionQueueSize)
+	}
+
+	if c.HealthCheckInterval <= 0 {
+		return fmt.Errorf("invalid HEALTH_CHECK_INTERVAL: %v (must be positive)", c.HealthCheckInterval)
+	}
+
+	if c.HealthCheckTimeout <= 0 {
+		return fmt.Errorf("invalid HEALTH_CHECK_TIMEOUT: %v (must be positive)", c.HealthCheckTimeout)
+	}
+
+	if c.PipelineStallTimeout <= 0 {
+		return fmt.Errorf("invalid PIPELINE_STALL_TIMEOUT: %v (must be positive)", c.PipelineStallTimeout)
+	}
+
+	switch c.AuditSink {
+	case AuditSinkRedis:
+		if c.AuditRetention <= 0 {
+			return fmt.Errorf("invalid AUDIT_RETENTION: %v (must be positive)", c.AuditRetention)
+		}
+	case AuditSinkFile:
+		if c.AuditFilePath == "" {
+			return fmt.Errorf("AUDIT_FILE_PATH is required when AUDIT_SINK is %q", AuditSinkFile)
+		}
+	case AuditSinkNone:
+	default:
+		return fmt.Errorf("invalid AUDIT_SINK: %q (must be %q, %q or %q)", c.AuditSink, AuditSinkRedis, AuditSinkFile, AuditSinkNone)
+	}
+
 	// ============================================================
 	// DEVELOPER: Add your custom validation below
 	// ============================================================
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	asyncapi_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
+	asyncapi_social "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AGS event names, as found in the "name" field of recorded events.
+const (
+	eventNameOAuthTokenGenerated = "oauthTokenGenerated"
+	eventNameStatItemUpdated     = "statItemUpdated"
+)
+
+// maxEventLineSize bounds the size of a single recorded event.
+const maxEventLineSize = 1024 * 1024
+
+// recordedEvent is one event read from a recording. Exactly one of oauth and stat is set.
+type recordedEvent struct {
+	line  int
+	oauth *asyncapi_iam.OauthTokenGenerated
+	stat  *asyncapi_social.StatItemUpdated
+}
+
+// namespace returns the namespace of the event.
+func (e *recordedEvent) namespace() string {
+	if e.oauth != nil {
+		return e.oauth.GetNamespace()
+	}
+	return e.stat.GetNamespace()
+}
+
+// time returns when the event occurred, from its "timestamp" field.
+// Returns false if the event has no valid timestamp.
+func (e *recordedEvent) time() (time.Time, bool) {
+	if e.oauth != nil {
+		return signal.ParseEventTime(e.oauth)
+	}
+	return signal.ParseEventTime(e.stat)
+}
+
+// readEvents reads protojson events, one per line. Blank lines are skipped.
+//
+// The event type is taken from the AGS "name" field. Events recorded without a name
+// are treated as StatItemUpdated when they carry a stat code, else as OauthTokenGenerated.
+func readEvents(r io.Reader) ([]*recordedEvent, error) {
+	var events []*recordedEvent
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		data := scanner.Bytes()
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
+		}
+
+		event, err := parseEvent(data)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		event.line = line
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read events: %w", err)
+	}
+
+	return events, nil
+}
+
+func parseEvent(data []byte) (*recordedEvent, error) {
+	var header struct {
+		Name    string `json:"name"`
+		Payload struct {
+			StatCode string `json:"statCode"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("invalid event: %w", err)
+	}
+
+	name := header.Name
+	if name == "" {
+		name = eventNameOAuthTokenGenerated
+		if header.Payload.StatCode != "" {
+			name = eventNameStatItemUpdated
+		}
+	}
+
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	switch {
+	case strings.EqualFold(name, eventNameOAuthTokenGenerated):
+		event := &asyncapi_iam.OauthTokenGenerated{}
+		if err := unmarshal.Unmarshal(data, event); err != nil {
+			return nil, fmt.Errorf("invalid %s event: %w", eventNameOAuthTokenGenerated, err)
+		}
+		return &recordedEvent{oauth: event}, nil
+	case strings.EqualFold(name, eventNameStatItemUpdated):
+		event := &asyncapi_social.StatItemUpdated{}
+		if err := unmarshal.Unmarshal(data, event); err != nil {
+			return nil, fmt.Errorf("invalid %s event: %w", eventNameStatItemUpdated, err)
+		}
+		return &recordedEvent{stat: event}, nil
+	default:
+		return nil, fmt.Errorf("unsupported event %q (supported: %s, %s)",
+			name, eventNameOAuthTokenGenerated, eventNameStatItemUpdated)
+	}
+}
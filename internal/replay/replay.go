@@ -0,0 +1,191 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package replay feeds recorded AGS events through the pipeline offline.
+//
+// Events are processed against a chosen pipeline.yaml with in-memory state and
+// with AGS calls recorded instead of made, and the rule decisions and action
+// results of each event are printed. This reproduces production incidents
+// locally and tests configuration changes before they ship.
+package replay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/internal/bootstrap"
+	"github.com/AccelByte/extend-churn-intervention/pkg/audit"
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+)
+
+// Options configures a replay.
+type Options struct {
+	// ConfigPath is the pipeline.yaml to evaluate events against.
+	ConfigPath string
+	// EventsPath is the file of recorded events, one protojson event per line.
+	EventsPath string
+	// Namespace is the AGS namespace. Defaults to the namespace of the first event.
+	Namespace string
+}
+
+// Run replays the recorded events and writes a report to out.
+// Events that fail to process are reported and do not stop the replay.
+func Run(ctx context.Context, opts Options, out io.Writer) error {
+	file, err := os.Open(opts.EventsPath)
+	if err != nil {
+		return fmt.Errorf("failed to open events: %w", err)
+	}
+	defer file.Close()
+
+	events, err := readEvents(file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.EventsPath, err)
+	}
+
+	namespace := opts.Namespace
+	if namespace == "" && len(events) > 0 {
+		namespace = events[0].namespace()
+	}
+
+	pipelineConfig, err := pipeline.LoadConfig(opts.ConfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to load pipeline config from %s: %w", opts.ConfigPath, err)
+	}
+
+	recorder := &callRecorder{}
+	sink := &lastRecordSink{}
+	virtual := clock.NewVirtual(time.Now())
+	manager, err := bootstrap.InitOfflinePipeline(pipelineConfig, namespace, &bootstrap.OfflineServices{OnCall: recorder.record}, virtual)
+	if err != nil {
+		return err
+	}
+	manager.SetAuditSink(sink)
+
+	fmt.Fprintf(out, "Replaying %d events from %s against %s (namespace %s)\n",
+		len(events), opts.EventsPath, opts.ConfigPath, namespace)
+
+	failed := 0
+	for i, event := range events {
+		// Replay at the recorded time, so that cooldowns and time windows see the
+		// original gaps between events. Events without a timestamp keep the time of
+		// the event before them.
+		if at, ok := event.time(); ok {
+			virtual.Set(at)
+		}
+
+		if event.oauth != nil {
+			err = manager.ProcessOAuthEvent(ctx, event.oauth)
+		} else {
+			err = manager.ProcessStatEvent(ctx, event.stat)
+		}
+		if err != nil {
+			failed++
+		}
+
+		printEvent(out, i+1, event, sink.take(), recorder.take(), err)
+	}
+
+	printSummary(out, len(events), failed, manager.GetStats())
+	return nil
+}
+
+// lastRecordSink keeps the audit record of the event being replayed.
+type lastRecordSink struct {
+	mu     sync.Mutex
+	record *audit.Record
+}
+
+func (s *lastRecordSink) Write(ctx context.Context, record *audit.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.record = record
+	return nil
+}
+
+// take returns the record written since the last take, or nil if the event produced no signal.
+func (s *lastRecordSink) take() *audit.Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record := s.record
+	s.record = nil
+	return record
+}
+
+func printEvent(out io.Writer, n int, event *recordedEvent, record *audit.Record, calls []string, err error) {
+	if event.oauth != nil {
+		fmt.Fprintf(out, "\n#%d (line %d) oauthTokenGenerated id=%s user=%s\n",
+			n, event.line, event.oauth.GetId(), event.oauth.GetUserId())
+	} else {
+		fmt.Fprintf(out, "\n#%d (line %d) statItemUpdated id=%s user=%s stat=%s value=%v\n",
+			n, event.line, event.stat.GetId(), event.stat.GetPayload().GetUserId(),
+			event.stat.GetPayload().GetStatCode(), event.stat.GetPayload().GetLatestValue())
+	}
+
+	if err != nil {
+		fmt.Fprintf(out, "  error: %v\n", err)
+	}
+	if record == nil {
+		if err == nil {
+			fmt.Fprintln(out, "  no signal")
+		}
+		return
+	}
+
+	fmt.Fprintf(out, "  signal %s\n", record.SignalType)
+	if len(record.Rules) == 0 {
+		fmt.Fprintln(out, "  no rules for this signal")
+	}
+	for _, decision := range record.Rules {
+		fmt.Fprintf(out, "  rule %s: %s", decision.RuleID, decision.Outcome)
+		if decision.Reason != "" {
+			fmt.Fprintf(out, " (%s)", decision.Reason)
+		}
+		fmt.Fprintln(out)
+	}
+	for _, decision := range record.Actions {
+		fmt.Fprintf(out, "  action %s (rule %s): %s", decision.ActionID, decision.RuleID, decision.Status)
+		switch {
+		case decision.Error != "":
+			fmt.Fprintf(out, " (%s)", decision.Error)
+		case decision.Would != "":
+			fmt.Fprintf(out, " (would %s)", decision.Would)
+		}
+		fmt.Fprintln(out)
+	}
+	for _, call := range calls {
+		fmt.Fprintf(out, "  AGS call: %s\n", call)
+	}
+}
+
+func printSummary(out io.Writer, events, failed int, stats pipeline.Stats) {
+	fmt.Fprintf(out, "\nSummary: %d events, %d failed\n", events, failed)
+
+	ruleIDs := make([]string, 0, len(stats.EngineStats.ByRule))
+	for ruleID := range stats.EngineStats.ByRule {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Strings(ruleIDs)
+	for _, ruleID := range ruleIDs {
+		s := stats.EngineStats.ByRule[ruleID]
+		fmt.Fprintf(out, "  rule %s: %d evaluations, %d triggers (%d shadow), %d suppressed by cooldown, %d errors\n",
+			ruleID, s.Evaluations, s.Triggers, s.ShadowTriggers, s.CooldownSuppressed, s.Errors)
+	}
+
+	actionIDs := make([]string, 0, len(stats.ExecutorStats.ByAction))
+	for actionID := range stats.ExecutorStats.ByAction {
+		actionIDs = append(actionIDs, actionID)
+	}
+	sort.Strings(actionIDs)
+	for _, actionID := range actionIDs {
+		s := stats.ExecutorStats.ByAction[actionID]
+		fmt.Fprintf(out, "  action %s: %d executions, %d failures, %d shadowed, %d rollbacks\n",
+			actionID, s.Executions, s.Failures, s.Shadowed, s.Rollbacks)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of the replay tests")
+
+// TestRun_Golden replays testdata/events.jsonl against testdata/pipeline.yaml and compares
+// the report with testdata/replay.golden. Run with -update after intended changes.
+func TestRun_Golden(t *testing.T) {
+	logrus.SetOutput(bytes.NewBuffer(nil))
+	t.Cleanup(func() { logrus.SetOutput(os.Stderr) })
+
+	var out bytes.Buffer
+	err := Run(context.Background(), Options{
+		ConfigPath: "testdata/pipeline.yaml",
+		EventsPath: "testdata/events.jsonl",
+	}, &out)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	const goldenPath = "testdata/replay.golden"
+	if *update {
+		if err := os.WriteFile(goldenPath, out.Bytes(), 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if got := out.String(); got != string(want) {
+		t.Errorf("replay report differs from %s (run with -update to accept):\n%s", goldenPath, got)
+	}
+}
+
+func TestReadEvents(t *testing.T) {
+	events, err := readEvents(strings.NewReader(`
+{"id":"e1","name":"oauthTokenGenerated","namespace":"mygame","userId":"u1"}
+
+{"id":"e2","namespace":"mygame","payload":{"statCode":"rse-rage-quit","userId":"u1","latestValue":3}}
+{"id":"e3","namespace":"mygame","userId":"u2"}
+`))
+	if err != nil {
+		t.Fatalf("readEvents() error = %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	if events[0].oauth == nil || events[0].line != 2 {
+		t.Errorf("expected an oauth event on line 2, got %+v", events[0])
+	}
+	if events[1].stat == nil || events[1].stat.GetPayload().GetStatCode() != "rse-rage-quit" {
+		t.Errorf("expected an unnamed event with a stat code to be a stat event, got %+v", events[1])
+	}
+	if events[2].oauth == nil || events[2].namespace() != "mygame" {
+		t.Errorf("expected an unnamed event without a stat code to be an oauth event, got %+v", events[2])
+	}
+
+	for _, line := range []string{
+		`{"id":"e1","name":"userBanned"}`,
+		`not json`,
+	} {
+		if _, err := readEvents(strings.NewReader(line)); err == nil {
+			t.Errorf("expected an error for %s", line)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	pb_admin "github.com/AccelByte/extend-churn-intervention/pkg/pb/churn-intervention/admin/v1"
+
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth/validator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// adminPermissionResource is the IAM permission resource required to call the admin API.
+// {namespace} is replaced with the namespace of the request by the token validator.
+const adminPermissionResource = "ADMIN:NAMESPACE:{namespace}:CHURN"
+
+// IAM permission actions
+const (
+	permissionActionRead   = 2
+	permissionActionUpdate = 4
+	permissionActionDelete = 8
+)
+
+// adminPermissionActions maps each admin method to the permission action it requires.
+// Methods not listed here (event handlers, health checks, reflection) are not authenticated.
+var adminPermissionActions = map[string]int{
+	pb_admin.ChurnAdminService_GetPlayerState_FullMethodName:            permissionActionRead,
+	pb_admin.ChurnAdminService_ClearCooldowns_FullMethodName:            permissionActionUpdate,
+	pb_admin.ChurnAdminService_UpdateInterventionOutcome_FullMethodName: permissionActionUpdate,
+	pb_admin.ChurnAdminService_DeleteChurnState_FullMethodName:          permissionActionDelete,
+	pb_admin.ChurnAdminService_ReloadPipelineConfig_FullMethodName:      permissionActionUpdate,
+}
+
+// newAdminAuthInterceptor returns an interceptor that requires admin API calls to carry an
+// AccelByte IAM bearer token with the admin permission for the namespace of the request,
+// or for namespace if the request names none.
+func newAdminAuthInterceptor(tokenValidator validator.AuthTokenValidator, namespace string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		action, ok := adminPermissionActions[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		token, err := bearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		requestNamespace := namespace
+		if r, ok := req.(interface{ GetNamespace() string }); ok && r.GetNamespace() != "" {
+			requestNamespace = r.GetNamespace()
+		}
+
+		permission := &iam.Permission{Resource: adminPermissionResource, Action: action}
+		if err := tokenValidator.Validate(token, permission, &requestNamespace, nil); err != nil {
+			logrus.Warnf("rejected admin call to %s: %v", info.FullMethod, err)
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// bearerToken extracts the bearer token from the authorization metadata.
+// The HTTP/JSON gateway forwards the Authorization header as this metadata.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+
+	token, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found || token == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+
+	return token, nil
+}
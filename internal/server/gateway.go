@@ -0,0 +1,75 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	pb_admin "github.com/AccelByte/extend-churn-intervention/pkg/pb/churn-intervention/admin/v1"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// GatewayServer serves the admin API as HTTP/JSON by proxying requests to the gRPC server.
+// Requests pass through the gRPC server's interceptors, so they are authenticated the same way;
+// the Authorization header is forwarded as gRPC metadata.
+type GatewayServer struct {
+	server   *http.Server
+	port     int
+	grpcPort int
+}
+
+// NewGatewayServer creates a new gateway server instance.
+func NewGatewayServer(port int, grpcPort int) *GatewayServer {
+	return &GatewayServer{
+		port:     port,
+		grpcPort: grpcPort,
+	}
+}
+
+// Setup registers the admin API routes.
+func (g *GatewayServer) Setup(ctx context.Context) error {
+	mux := runtime.NewServeMux()
+
+	endpoint := fmt.Sprintf("localhost:%d", g.grpcPort)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb_admin.RegisterChurnAdminServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register admin gateway: %w", err)
+	}
+
+	g.server = &http.Server{
+		Addr:    fmt.Sprintf(":%d", g.port),
+		Handler: mux,
+	}
+
+	logrus.Infof("admin gateway configured for gRPC endpoint %s", endpoint)
+	return nil
+}
+
+// Start begins serving HTTP requests.
+func (g *GatewayServer) Start(ctx context.Context) error {
+	go func() {
+		logrus.Infof("admin gateway listening on port %d", g.port)
+		if err := g.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logrus.Fatalf("admin gateway failed: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Shutdown gracefully stops the gateway server.
+func (g *GatewayServer) Shutdown(ctx context.Context) error {
+	logrus.Info("shutting down admin gateway...")
+	if err := g.server.Shutdown(ctx); err != nil {
+		return err
+	}
+	logrus.Info("admin gateway stopped")
+	return nil
+}
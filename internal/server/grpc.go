@@ -11,25 +11,36 @@ import (
 
 	"github.com/AccelByte/extend-churn-intervention/pkg/common"
 	"github.com/AccelByte/extend-churn-intervention/pkg/handler"
+	"github.com/AccelByte/extend-churn-intervention/pkg/health"
 	pb_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
 	pb_social "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	pb_admin "github.com/AccelByte/extend-churn-intervention/pkg/pb/churn-intervention/admin/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
 
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth/validator"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
+	grpchealth "google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+// livenessService is the gRPC health service name reporting liveness. The overall
+// status (service "") reports readiness.
+const livenessService = "liveness"
+
 // GRPCServer manages the gRPC server lifecycle.
 type GRPCServer struct {
-	server    *grpc.Server
-	port      int
-	manager   *pipeline.Manager
-	namespace string
+	server         *grpc.Server
+	port           int
+	manager        *pipeline.Manager
+	namespace      string
+	admin          pb_admin.ChurnAdminServiceServer
+	tokenValidator validator.AuthTokenValidator
+	healthChecker  *health.Checker
+	healthServer   *grpchealth.Server
 }
 
 // NewGRPCServer creates a new gRPC server instance.
@@ -41,6 +52,20 @@ func NewGRPCServer(port int, manager *pipeline.Manager, namespace string) *GRPCS
 	}
 }
 
+// EnableAdmin registers the admin API. Call it before Setup.
+// Admin calls must carry a token that tokenValidator accepts for the admin permission;
+// a nil tokenValidator disables authentication (local development only).
+func (s *GRPCServer) EnableAdmin(admin pb_admin.ChurnAdminServiceServer, tokenValidator validator.AuthTokenValidator) {
+	s.admin = admin
+	s.tokenValidator = tokenValidator
+}
+
+// SetHealthChecker makes the gRPC health service report the checker's readiness and
+// liveness instead of always SERVING. Call it before Setup.
+func (s *GRPCServer) SetHealthChecker(checker *health.Checker) {
+	s.healthChecker = checker
+}
+
 // Setup configures the gRPC server with interceptors and registers handlers.
 //
 // ============================================================
@@ -72,6 +97,9 @@ func (s *GRPCServer) Setup() error {
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		logging.StreamServerInterceptor(common.InterceptorLogger(logrus.StandardLogger())),
 	}
+	if s.admin != nil && s.tokenValidator != nil {
+		unaryInterceptors = append(unaryInterceptors, newAdminAuthInterceptor(s.tokenValidator, s.namespace))
+	}
 
 	// Create server with OpenTelemetry instrumentation
 	s.server = grpc.NewServer(
@@ -97,20 +125,43 @@ func (s *GRPCServer) Setup() error {
 	// ============================================================
 	oauthHandler := handler.NewOAuth(s.manager, s.namespace)
 	pb_iam.RegisterOauthTokenOauthTokenGeneratedServiceServer(s.server, oauthHandler)
+	pb_iam.RegisterOauthTokenOauthTokenRevokedServiceServer(s.server, handler.NewOAuthTokenRevoked(s.manager, s.namespace))
 
 	statisticHandler := handler.NewStatistic(s.manager, s.namespace)
 	pb_social.RegisterStatisticStatItemUpdatedServiceServer(s.server, statisticHandler)
 
-	logrus.Infof("registered event listeners: OAuth and Statistic")
+	// Stat lifecycle: first-time stats, deleted stats and stat cycle (season) resets
+	pb_social.RegisterStatisticStatItemCreatedServiceServer(s.server, handler.NewStatItemCreated(s.manager, s.namespace))
+	pb_social.RegisterStatisticStatItemDeletedServiceServer(s.server, handler.NewStatItemDeleted(s.manager, s.namespace))
+	pb_social.RegisterStatisticCycleStatItemCycleUpdatedServiceServer(s.server, handler.NewStatItemCycleUpdated(s.manager, s.namespace))
+	pb_social.RegisterStatCycleManagementStatCycleResetServiceServer(s.server, handler.NewStatCycleReset(s.manager, s.namespace))
+
+	logrus.Infof("registered event listeners: OAuth, Statistic and stat lifecycle")
+
+	if s.admin != nil {
+		pb_admin.RegisterChurnAdminServiceServer(s.server, s.admin)
+		if s.tokenValidator == nil {
+			logrus.Warn("registered admin API WITHOUT authentication")
+		} else {
+			logrus.Info("registered admin API")
+		}
+	}
 
 	// ============================================================
 	// Enable gRPC server features
 	// ============================================================
 	// - Reflection: allows tools like grpcurl to inspect services
-	// - Health check: for Kubernetes liveness/readiness probes
+	// - Health check: for Kubernetes liveness/readiness probes.
+	//   Service "" reports readiness and service "liveness" liveness.
 	// ============================================================
 	reflection.Register(s.server)
-	grpc_health_v1.RegisterHealthServer(s.server, health.NewServer())
+	s.healthServer = grpchealth.NewServer()
+	if s.healthChecker != nil {
+		// Not serving until the first check has run
+		s.setHealthStatus(false, false)
+		s.healthChecker.OnChange(s.setHealthStatus)
+	}
+	grpc_health_v1.RegisterHealthServer(s.server, s.healthServer)
 
 	logrus.Infof("gRPC reflection and health check enabled")
 
@@ -137,7 +188,21 @@ func (s *GRPCServer) Start(ctx context.Context) error {
 // Shutdown gracefully stops the gRPC server.
 func (s *GRPCServer) Shutdown(ctx context.Context) error {
 	logrus.Info("shutting down gRPC server...")
+	s.healthServer.Shutdown() // Report NOT_SERVING while in-flight calls finish
 	s.server.GracefulStop()
 	logrus.Info("gRPC server stopped")
 	return nil
 }
+
+// setHealthStatus updates the gRPC health service from the health checker.
+func (s *GRPCServer) setHealthStatus(ready, live bool) {
+	s.healthServer.SetServingStatus("", servingStatus(ready))
+	s.healthServer.SetServingStatus(livenessService, servingStatus(live))
+}
+
+func servingStatus(healthy bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if healthy {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
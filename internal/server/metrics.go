@@ -6,20 +6,52 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/health"
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 )
 
+// statsEndpoint serves pipeline statistics as JSON when a StatsProvider is set.
+const statsEndpoint = "/stats"
+
+// configStatusEndpoint serves the status of the pipeline configurations as JSON when a
+// ConfigStatusProvider is set.
+const configStatusEndpoint = "/config"
+
+// Health endpoints served when a health checker is set. They respond 200 when
+// healthy and 503 otherwise, with the health report as JSON.
+const (
+	readinessEndpoint = "/readyz"
+	livenessEndpoint  = "/livez"
+)
+
+// StatsProvider provides the pipeline statistics served on the stats endpoint.
+type StatsProvider interface {
+	GetStats() pipeline.Stats
+}
+
+// ConfigStatusProvider provides the status of the pipeline configurations served on the
+// config endpoint.
+type ConfigStatusProvider interface {
+	ConfigStatus() []pipeline.ConfigStatus
+}
+
 // MetricsServer manages the Prometheus metrics HTTP server.
 type MetricsServer struct {
-	server   *http.Server
-	port     int
-	endpoint string
+	server         *http.Server
+	port           int
+	endpoint       string
+	statsProvider  StatsProvider
+	configProvider ConfigStatusProvider
+	healthChecker  *health.Checker
 }
 
 // NewMetricsServer creates a new metrics server instance.
@@ -30,8 +62,28 @@ func NewMetricsServer(port int, endpoint string) *MetricsServer {
 	}
 }
 
+// SetStatsProvider enables the /stats JSON endpoint. Call it before Setup.
+func (m *MetricsServer) SetStatsProvider(provider StatsProvider) {
+	m.statsProvider = provider
+}
+
+// SetConfigStatusProvider enables the /config JSON endpoint. Call it before Setup.
+func (m *MetricsServer) SetConfigStatusProvider(provider ConfigStatusProvider) {
+	m.configProvider = provider
+}
+
+// SetHealthChecker enables the /readyz and /livez endpoints. Call it before Setup.
+func (m *MetricsServer) SetHealthChecker(checker *health.Checker) {
+	m.healthChecker = checker
+}
+
 // Setup configures the metrics server and registers collectors.
 //
+// Besides the Go runtime and process collectors, it registers the pipeline
+// metrics defined in pkg/metrics: events received per handler, signal processing
+// latency, rule evaluations and triggers, action executions, failures, rollbacks
+// and durations, and churn state store latency.
+//
 // ============================================================
 // DEVELOPER: Register custom Prometheus metrics here
 // ============================================================
@@ -65,6 +117,35 @@ func (m *MetricsServer) Setup() error {
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
 
+	// Register pipeline metrics
+	registry.MustRegister(
+		metrics.DuplicateEventsDroppedTotal,
+		metrics.LateEventsDroppedTotal,
+		metrics.LaneQueueDepth,
+		metrics.LaneQueueLatencySeconds,
+		metrics.ActionRetriesTotal,
+		metrics.ActionRetriesExhaustedTotal,
+		metrics.AsyncActionQueueDepth,
+		metrics.AsyncActionQueueFullTotal,
+		metrics.AsyncActionFailuresTotal,
+		metrics.RuleCooldownSuppressedTotal,
+		metrics.PipelineConfigInfo,
+		metrics.PipelineConfigReloadsTotal,
+		metrics.PipelineConfigReloadFailing,
+		metrics.RuleShadowTriggersTotal,
+		metrics.ShadowActionExecutionsTotal,
+		metrics.EventsReceivedTotal,
+		metrics.SignalProcessingDurationSeconds,
+		metrics.RuleEvaluationsTotal,
+		metrics.RuleTriggersTotal,
+		metrics.ActionExecutionsTotal,
+		metrics.ActionFailuresTotal,
+		metrics.ActionRollbacksTotal,
+		metrics.ActionExecutionDurationSeconds,
+		metrics.StateStoreDurationSeconds,
+		metrics.AuditWriteFailuresTotal,
+	)
+
 	// ============================================================
 	// DEVELOPER: Register custom metrics below
 	// ============================================================
@@ -75,6 +156,20 @@ func (m *MetricsServer) Setup() error {
 
 	mux := http.NewServeMux()
 	mux.Handle(m.endpoint, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	if m.statsProvider != nil {
+		mux.HandleFunc(statsEndpoint, m.handleStats)
+	}
+	if m.configProvider != nil {
+		mux.HandleFunc(configStatusEndpoint, m.handleConfigStatus)
+	}
+	if m.healthChecker != nil {
+		mux.HandleFunc(readinessEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeHealthReport(w, r, m.healthChecker.Readiness())
+		})
+		mux.HandleFunc(livenessEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeHealthReport(w, r, m.healthChecker.Liveness())
+		})
+	}
 
 	m.server = &http.Server{
 		Addr:    fmt.Sprintf(":%d", m.port),
@@ -104,3 +199,48 @@ func (m *MetricsServer) Shutdown(ctx context.Context) error {
 	logrus.Info("metrics server stopped")
 	return nil
 }
+
+// handleStats serves the current pipeline statistics as JSON.
+func (m *MetricsServer) handleStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(m.statsProvider.GetStats()); err != nil {
+		logrus.Errorf("failed to encode pipeline stats: %v", err)
+	}
+}
+
+// handleConfigStatus serves the status of the pipeline configurations as JSON.
+func (m *MetricsServer) handleConfigStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(m.configProvider.ConfigStatus()); err != nil {
+		logrus.Errorf("failed to encode pipeline config status: %v", err)
+	}
+}
+
+// writeHealthReport serves a health report as JSON, with status 503 when unhealthy.
+func writeHealthReport(w http.ResponseWriter, r *http.Request, report health.Report) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logrus.Errorf("failed to encode health report: %v", err)
+	}
+}
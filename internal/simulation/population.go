@@ -0,0 +1,179 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package simulation
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	asyncapi_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
+	asyncapi_social "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+)
+
+// Stat codes reported by the simulated game server.
+const (
+	statCodeLosingStreak = "rse-current-losing-streak"
+	statCodeRageQuit     = "rse-rage-quit"
+)
+
+const (
+	week = 7 * 24 * time.Hour
+	// matchLength is the time between the login or previous match and the end of a match.
+	matchLength = 20 * time.Minute
+)
+
+// player is a simulated player and the ground truth the report is measured against.
+type player struct {
+	userID  string
+	segment string
+	// churnWeek is the week the player started churning, or -1 if they never did.
+	churnWeek int
+}
+
+// event is a generated AGS event. Exactly one of oauth and stat is set.
+type event struct {
+	at    time.Time
+	oauth *asyncapi_iam.OauthTokenGenerated
+	stat  *asyncapi_social.StatItemUpdated
+}
+
+// population is the generated players and their events, sorted by time.
+type population struct {
+	players []*player
+	events  []*event
+}
+
+// generatePopulation simulates the players of the scenario week by week.
+//
+// An engaged player logs in a Poisson-distributed number of times per week and plays
+// matches at each login. Every loss extends the losing streak and may end in a rage
+// quit; a win resets the streak. Each week an engaged player may start churning, after
+// which their login rate is multiplied by the segment's churn decay every week.
+func generatePopulation(scenario *Scenario, namespace string) *population {
+	rng := rand.New(rand.NewSource(scenario.Seed))
+	pop := &population{}
+
+	for _, segment := range scenario.Segments {
+		for i := 0; i < segment.Players; i++ {
+			p := &player{
+				userID:    fmt.Sprintf("sim-%s-%04d", segment.Name, i),
+				segment:   segment.Name,
+				churnWeek: -1,
+			}
+			pop.players = append(pop.players, p)
+			pop.events = append(pop.events, simulatePlayer(rng, scenario, segment, p, namespace)...)
+		}
+	}
+
+	sort.SliceStable(pop.events, func(i, j int) bool {
+		return pop.events[i].at.Before(pop.events[j].at)
+	})
+	for i, e := range pop.events {
+		id := fmt.Sprintf("sim-event-%d", i+1)
+		if e.oauth != nil {
+			e.oauth.Id = id
+		} else {
+			e.stat.Id = id
+		}
+	}
+
+	return pop
+}
+
+func simulatePlayer(rng *rand.Rand, scenario *Scenario, segment Segment, p *player, namespace string) []*event {
+	var events []*event
+	losingStreak, rageQuits := 0, 0
+	loginRate := segment.LoginsPerWeek
+
+	for w := 0; w < scenario.Weeks; w++ {
+		weekStart := scenario.Start.Add(time.Duration(w) * week)
+		rageQuitsThisWeek := 0
+
+		logins := poisson(rng, loginRate)
+		offsets := make([]time.Duration, logins)
+		for i := range offsets {
+			offsets[i] = time.Duration(rng.Int63n(int64(week)))
+		}
+		sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+		for _, offset := range offsets {
+			at := weekStart.Add(offset)
+			events = append(events, &event{at: at, oauth: &asyncapi_iam.OauthTokenGenerated{
+				Namespace: namespace,
+				Timestamp: at.Format(time.RFC3339),
+				UserId:    p.userID,
+			}})
+
+			matches := poisson(rng, segment.MatchesPerLogin)
+			for m := 0; m < matches; m++ {
+				at = at.Add(matchLength)
+				if rng.Float64() < segment.WinRate {
+					if losingStreak > 0 {
+						losingStreak = 0
+						events = append(events, newStatEvent(at, namespace, p.userID, statCodeLosingStreak, 0))
+					}
+					continue
+				}
+
+				losingStreak++
+				events = append(events, newStatEvent(at, namespace, p.userID, statCodeLosingStreak, losingStreak))
+
+				if rng.Float64() < segment.RageQuitRate {
+					rageQuits++
+					rageQuitsThisWeek++
+					events = append(events, newStatEvent(at, namespace, p.userID, statCodeRageQuit, rageQuits))
+					break
+				}
+			}
+		}
+
+		if p.churnWeek >= 0 {
+			loginRate *= *segment.ChurnDecay
+			continue
+		}
+		// Churn starting after the last week could not be observed by the rules.
+		if w+1 == scenario.Weeks {
+			continue
+		}
+		churnProbability := segment.ChurnProbability + float64(rageQuitsThisWeek)*segment.ChurnProbabilityPerRageQuit
+		if rng.Float64() < churnProbability {
+			p.churnWeek = w + 1
+			loginRate *= *segment.ChurnDecay
+		}
+	}
+
+	return events
+}
+
+func newStatEvent(at time.Time, namespace, userID, statCode string, value int) *event {
+	return &event{at: at, stat: &asyncapi_social.StatItemUpdated{
+		Namespace: namespace,
+		Timestamp: at.Format(time.RFC3339),
+		UserId:    userID,
+		Payload: &asyncapi_social.StatItem{
+			UserId:      userID,
+			StatCode:    statCode,
+			LatestValue: float64(value),
+		},
+	}}
+}
+
+// poisson draws from a Poisson distribution with the given mean (Knuth's algorithm).
+func poisson(rng *rand.Rand, mean float64) int {
+	if mean <= 0 {
+		return 0
+	}
+
+	limit := math.Exp(-mean)
+	k, product := 0, rng.Float64()
+	for product > limit {
+		k++
+		product *= rng.Float64()
+	}
+	return k
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// printReport writes the population summary and one column per config.
+func printReport(out io.Writer, scenario *Scenario, pop *population, results []*Result) {
+	churners := make(map[string]bool)
+	for _, p := range pop.players {
+		if p.churnWeek >= 0 {
+			churners[p.userID] = true
+		}
+	}
+	players := len(pop.players)
+	playerWeeks := float64(players * scenario.Weeks)
+
+	fmt.Fprintf(out, "Simulated %d players in %d segments over %d weeks from %s (seed %d)\n",
+		players, len(scenario.Segments), scenario.Weeks, scenario.Start.Format("2006-01-02"), scenario.Seed)
+	fmt.Fprintf(out, "%d events, %d churners (%s)\n\n", len(pop.events), len(churners), percent(len(churners), players))
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	row := func(label string, cell func(r *Result) string) {
+		cells := make([]string, 0, len(results))
+		for _, r := range results {
+			cells = append(cells, cell(r))
+		}
+		fmt.Fprintf(w, "%s\t%s\n", label, strings.Join(cells, "\t"))
+	}
+
+	row("", func(r *Result) string { return r.Config })
+	row("failed events", func(r *Result) string { return fmt.Sprint(r.FailedEvents) })
+
+	for _, ruleID := range ruleIDs(results) {
+		rule := func(cell func(rr *RuleResult) string) func(r *Result) string {
+			return func(r *Result) string {
+				rr, ok := r.Rules[ruleID]
+				if !ok {
+					return "-"
+				}
+				return cell(rr)
+			}
+		}
+
+		row("rule "+ruleID, func(r *Result) string { return "" })
+		row("  triggers", rule(func(rr *RuleResult) string {
+			return fmt.Sprintf("%d (%.1f per 1k players/week)", rr.Triggers, float64(rr.Triggers)*1000/playerWeeks)
+		}))
+		row("  shadow triggers", rule(func(rr *RuleResult) string { return fmt.Sprint(rr.ShadowTriggers) }))
+		row("  suppressed by cooldown", rule(func(rr *RuleResult) string { return fmt.Sprint(rr.CooldownSuppressed) }))
+		row("  players triggered", rule(func(rr *RuleResult) string {
+			return fmt.Sprintf("%d (%s)", len(rr.Players), percent(len(rr.Players), players))
+		}))
+		row("  of which churners", rule(func(rr *RuleResult) string {
+			return fmt.Sprint(count(rr.Players, churners))
+		}))
+	}
+
+	for _, actionID := range actionIDs(results) {
+		action := func(cell func(ar *ActionResult) string) func(r *Result) string {
+			return func(r *Result) string {
+				ar, ok := r.Actions[actionID]
+				if !ok {
+					return "-"
+				}
+				return cell(ar)
+			}
+		}
+
+		row("action "+actionID, func(r *Result) string { return "" })
+		row("  executions", action(func(ar *ActionResult) string { return fmt.Sprint(ar.Executions) }))
+		row("  shadowed", action(func(ar *ActionResult) string { return fmt.Sprint(ar.Shadowed) }))
+		row("  cost", action(func(ar *ActionResult) string {
+			return fmt.Sprintf("%.2f", float64(ar.Executions)*scenario.Costs[actionID])
+		}))
+	}
+
+	row("interventions", func(r *Result) string { return "" })
+	row("  players reached", func(r *Result) string {
+		return fmt.Sprintf("%d (%s)", len(r.Intervened), percent(len(r.Intervened), players))
+	})
+	row("  precision (churners / reached)", func(r *Result) string {
+		return percent(count(r.Intervened, churners), len(r.Intervened))
+	})
+	row("  recall (churners reached)", func(r *Result) string {
+		return percent(count(r.Intervened, churners), len(churners))
+	})
+	row("  total cost", func(r *Result) string { return fmt.Sprintf("%.2f", r.Cost) })
+	row("  cost per churner reached", func(r *Result) string {
+		reached := count(r.Intervened, churners)
+		if reached == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.2f", r.Cost/float64(reached))
+	})
+
+	w.Flush()
+}
+
+// ruleIDs returns the rules of all results, sorted.
+func ruleIDs(results []*Result) []string {
+	seen := make(map[string]bool)
+	for _, r := range results {
+		for id := range r.Rules {
+			seen[id] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// actionIDs returns the actions of all results, sorted.
+func actionIDs(results []*Result) []string {
+	seen := make(map[string]bool)
+	for _, r := range results {
+		for id := range r.Actions {
+			seen[id] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// count returns the number of players in both sets.
+func count(players, churners map[string]bool) int {
+	n := 0
+	for userID := range players {
+		if churners[userID] {
+			n++
+		}
+	}
+	return n
+}
+
+func percent(n, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(total))
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario defaults.
+const (
+	DefaultWeeks      = 8
+	DefaultChurnDecay = 0.5
+)
+
+// defaultStart is a Monday, so simulated weeks line up with ISO weeks.
+var defaultStart = time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+// Scenario describes the synthetic player population to simulate.
+type Scenario struct {
+	// Seed makes the population reproducible. The same seed yields the same events for every config.
+	Seed int64 `yaml:"seed"`
+	// Weeks is the number of simulated weeks.
+	Weeks int `yaml:"weeks"`
+	// Start is the simulated time of the first week. Defaults to Monday 2026-01-05 UTC.
+	Start time.Time `yaml:"start"`
+	// Segments are the player segments of the population.
+	Segments []Segment `yaml:"segments"`
+	// Costs is the cost of one successful execution, keyed by action ID.
+	// Actions without a cost count as free.
+	Costs map[string]float64 `yaml:"costs"`
+}
+
+// Segment is a group of players with the same behavior.
+type Segment struct {
+	Name    string `yaml:"name"`
+	Players int    `yaml:"players"`
+	// LoginsPerWeek is the mean number of logins per week of an engaged player.
+	LoginsPerWeek float64 `yaml:"logins_per_week"`
+	// MatchesPerLogin is the mean number of matches played per login.
+	MatchesPerLogin float64 `yaml:"matches_per_login"`
+	// WinRate is the chance of winning a match.
+	WinRate float64 `yaml:"win_rate"`
+	// RageQuitRate is the chance of rage quitting after a lost match, which ends the session.
+	RageQuitRate float64 `yaml:"rage_quit_rate"`
+	// ChurnProbability is the weekly chance that an engaged player starts churning.
+	ChurnProbability float64 `yaml:"churn_probability"`
+	// ChurnProbabilityPerRageQuit is added to the weekly churn chance for each rage quit of the week.
+	ChurnProbabilityPerRageQuit float64 `yaml:"churn_probability_per_rage_quit"`
+	// ChurnDecay multiplies the login rate of a churning player every week. Defaults to 0.5.
+	ChurnDecay *float64 `yaml:"churn_decay"`
+}
+
+// LoadScenario reads a scenario from a YAML file and applies defaults.
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario file: %w", err)
+	}
+
+	var scenario Scenario
+	if err := yaml.Unmarshal(data, &scenario); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario YAML: %w", err)
+	}
+
+	if scenario.Weeks == 0 {
+		scenario.Weeks = DefaultWeeks
+	}
+	if scenario.Start.IsZero() {
+		scenario.Start = defaultStart
+	}
+	for i := range scenario.Segments {
+		if scenario.Segments[i].ChurnDecay == nil {
+			decay := DefaultChurnDecay
+			scenario.Segments[i].ChurnDecay = &decay
+		}
+	}
+
+	if err := scenario.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario: %w", err)
+	}
+	return &scenario, nil
+}
+
+// Validate checks that the scenario describes a population that can be simulated.
+func (s *Scenario) Validate() error {
+	if s.Weeks < 1 {
+		return errors.New("weeks must be at least 1")
+	}
+	if len(s.Segments) == 0 {
+		return errors.New("at least one segment is required")
+	}
+
+	names := make(map[string]bool)
+	for i, segment := range s.Segments {
+		if segment.Name == "" {
+			return fmt.Errorf("segment %d: name is required", i)
+		}
+		if names[segment.Name] {
+			return fmt.Errorf("duplicate segment name: %s", segment.Name)
+		}
+		names[segment.Name] = true
+
+		if segment.Players < 1 {
+			return fmt.Errorf("segment %s: players must be at least 1", segment.Name)
+		}
+		if segment.LoginsPerWeek < 0 || segment.MatchesPerLogin < 0 {
+			return fmt.Errorf("segment %s: logins_per_week and matches_per_login must not be negative", segment.Name)
+		}
+		probabilities := map[string]float64{
+			"win_rate":                        segment.WinRate,
+			"rage_quit_rate":                  segment.RageQuitRate,
+			"churn_probability":               segment.ChurnProbability,
+			"churn_probability_per_rage_quit": segment.ChurnProbabilityPerRageQuit,
+		}
+		if segment.ChurnDecay != nil {
+			probabilities["churn_decay"] = *segment.ChurnDecay
+		}
+		for field, value := range probabilities {
+			if value < 0 || value > 1 {
+				return fmt.Errorf("segment %s: %s must be between 0 and 1, got %v", segment.Name, field, value)
+			}
+		}
+	}
+
+	for actionID, cost := range s.Costs {
+		if cost < 0 {
+			return fmt.Errorf("cost of action %s must not be negative", actionID)
+		}
+	}
+	return nil
+}
+
+// players returns the total number of players in the scenario.
+func (s *Scenario) players() int {
+	total := 0
+	for _, segment := range s.Segments {
+		total += segment.Players
+	}
+	return total
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package simulation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadScenario(t *testing.T) {
+	scenario, err := LoadScenario("testdata/scenario.yaml")
+	if err != nil {
+		t.Fatalf("LoadScenario() error = %v", err)
+	}
+
+	if scenario.Weeks != 4 || scenario.players() != 20 {
+		t.Errorf("expected 20 players over 4 weeks, got %d over %d", scenario.players(), scenario.Weeks)
+	}
+	if !scenario.Start.Equal(defaultStart) {
+		t.Errorf("expected default start %v, got %v", defaultStart, scenario.Start)
+	}
+	if decay := scenario.Segments[0].ChurnDecay; decay == nil || *decay != DefaultChurnDecay {
+		t.Errorf("expected default churn decay %v, got %v", DefaultChurnDecay, decay)
+	}
+}
+
+func TestScenario_Validate(t *testing.T) {
+	valid := func() *Scenario {
+		decay := DefaultChurnDecay
+		return &Scenario{
+			Weeks:    1,
+			Segments: []Segment{{Name: "casual", Players: 1, WinRate: 0.5, ChurnDecay: &decay}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(s *Scenario)
+		wantErr string
+	}{
+		{"valid", func(s *Scenario) {}, ""},
+		{"no weeks", func(s *Scenario) { s.Weeks = 0 }, "weeks must be at least 1"},
+		{"no segments", func(s *Scenario) { s.Segments = nil }, "at least one segment"},
+		{"unnamed segment", func(s *Scenario) { s.Segments[0].Name = "" }, "name is required"},
+		{"duplicate segment", func(s *Scenario) { s.Segments = append(s.Segments, s.Segments[0]) }, "duplicate segment name"},
+		{"no players", func(s *Scenario) { s.Segments[0].Players = 0 }, "players must be at least 1"},
+		{"negative rate", func(s *Scenario) { s.Segments[0].LoginsPerWeek = -1 }, "must not be negative"},
+		{"probability above 1", func(s *Scenario) { s.Segments[0].WinRate = 1.5 }, "win_rate must be between 0 and 1"},
+		{"negative cost", func(s *Scenario) { s.Costs = map[string]float64{"grant-item": -1} }, "must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenario := valid()
+			tt.modify(scenario)
+
+			err := scenario.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
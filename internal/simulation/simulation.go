@@ -0,0 +1,193 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package simulation backtests pipeline configurations against a synthetic player population.
+//
+// A scenario describes player segments (login frequency, win rate, rage-quit propensity,
+// churn probability). The simulator generates their events over simulated weeks, runs them
+// through the real rules and actions of each pipeline.yaml under a virtual clock, with
+// in-memory state and without calling AGS, and reports trigger rates, intervention volume
+// and cost per config side by side. Every config sees the same population.
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/AccelByte/extend-churn-intervention/internal/bootstrap"
+	"github.com/AccelByte/extend-churn-intervention/pkg/audit"
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+)
+
+// DefaultNamespace is the namespace of the simulated events.
+const DefaultNamespace = "simulation"
+
+// Options configures a simulation.
+type Options struct {
+	// ScenarioPath is the scenario YAML describing the population.
+	ScenarioPath string
+	// ConfigPaths are the pipeline.yaml files to compare.
+	ConfigPaths []string
+	// Namespace is the AGS namespace of the simulated events. Defaults to DefaultNamespace.
+	Namespace string
+}
+
+// Run simulates the scenario against each config and writes a comparison report to out.
+func Run(ctx context.Context, opts Options, out io.Writer) error {
+	scenario, err := LoadScenario(opts.ScenarioPath)
+	if err != nil {
+		return err
+	}
+
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+
+	pop := generatePopulation(scenario, namespace)
+
+	results := make([]*Result, 0, len(opts.ConfigPaths))
+	for _, path := range opts.ConfigPaths {
+		pipelineConfig, err := pipeline.LoadConfig(path)
+		if err != nil {
+			return fmt.Errorf("failed to load pipeline config from %s: %w", path, err)
+		}
+
+		result, err := simulate(ctx, scenario, pop, pipelineConfig, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to simulate %s: %w", path, err)
+		}
+		result.Config = path
+		results = append(results, result)
+	}
+
+	printReport(out, scenario, pop, results)
+	return nil
+}
+
+// Result is the outcome of simulating one config.
+type Result struct {
+	Config string
+	// Events is the number of events processed and FailedEvents the number that failed.
+	Events       int
+	FailedEvents int
+	Rules        map[string]*RuleResult
+	Actions      map[string]*ActionResult
+	// Intervened is the set of players at least one action was executed for.
+	Intervened map[string]bool
+	// Cost is the total cost of the executed actions.
+	Cost float64
+}
+
+// RuleResult counts the decisions of a rule.
+type RuleResult struct {
+	Triggers           int
+	ShadowTriggers     int
+	CooldownSuppressed int
+	Errors             int
+	// Players is the set of players the rule triggered for, including shadow triggers.
+	Players map[string]bool
+}
+
+// ActionResult counts the results of an action.
+type ActionResult struct {
+	Executions int
+	Shadowed   int
+	Failures   int
+}
+
+// simulate runs the population through the pipeline built from pipelineConfig.
+func simulate(ctx context.Context, scenario *Scenario, pop *population, pipelineConfig *pipeline.Config, namespace string) (*Result, error) {
+	virtual := clock.NewVirtual(scenario.Start)
+	manager, err := bootstrap.InitOfflinePipeline(pipelineConfig, namespace, &bootstrap.OfflineServices{}, virtual)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Rules:      make(map[string]*RuleResult),
+		Actions:    make(map[string]*ActionResult),
+		Intervened: make(map[string]bool),
+	}
+	for _, ruleConfig := range pipelineConfig.Rules {
+		if ruleConfig.Enabled {
+			result.Rules[ruleConfig.ID] = &RuleResult{Players: make(map[string]bool)}
+		}
+	}
+	for _, actionConfig := range pipelineConfig.Actions {
+		if actionConfig.Enabled {
+			result.Actions[actionConfig.ID] = &ActionResult{}
+		}
+	}
+	manager.SetAuditSink(&resultSink{result: result, costs: scenario.Costs})
+
+	for _, e := range pop.events {
+		virtual.Set(e.at)
+
+		if e.oauth != nil {
+			err = manager.ProcessOAuthEvent(ctx, e.oauth)
+		} else {
+			err = manager.ProcessStatEvent(ctx, e.stat)
+		}
+		result.Events++
+		if err != nil {
+			result.FailedEvents++
+		}
+	}
+
+	return result, nil
+}
+
+// resultSink tallies audit records into a Result. Events are processed one at a time,
+// so it needs no locking.
+type resultSink struct {
+	result *Result
+	costs  map[string]float64
+}
+
+func (s *resultSink) Write(ctx context.Context, record *audit.Record) error {
+	for _, decision := range record.Rules {
+		ruleResult, ok := s.result.Rules[decision.RuleID]
+		if !ok {
+			continue
+		}
+
+		switch decision.Outcome {
+		case rule.DecisionTriggered:
+			ruleResult.Triggers++
+			ruleResult.Players[record.UserID] = true
+		case rule.DecisionShadowTriggered:
+			ruleResult.ShadowTriggers++
+			ruleResult.Players[record.UserID] = true
+		case rule.DecisionCooldownSuppressed:
+			ruleResult.CooldownSuppressed++
+		case rule.DecisionError:
+			ruleResult.Errors++
+		}
+	}
+
+	for _, decision := range record.Actions {
+		actionResult, ok := s.result.Actions[decision.ActionID]
+		if !ok {
+			actionResult = &ActionResult{}
+			s.result.Actions[decision.ActionID] = actionResult
+		}
+
+		switch decision.Status {
+		case audit.ActionStatusSucceeded, audit.ActionStatusQueued:
+			actionResult.Executions++
+			s.result.Intervened[record.UserID] = true
+			s.result.Cost += s.costs[decision.ActionID]
+		case audit.ActionStatusShadow:
+			actionResult.Shadowed++
+		case audit.ActionStatusFailed:
+			actionResult.Failures++
+		}
+	}
+
+	return nil
+}
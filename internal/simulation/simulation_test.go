@@ -0,0 +1,123 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	"github.com/sirupsen/logrus"
+)
+
+// silenceLogs discards the pipeline logs of the test.
+func silenceLogs(t *testing.T) {
+	t.Helper()
+	logrus.SetOutput(io.Discard)
+	t.Cleanup(func() { logrus.SetOutput(os.Stderr) })
+}
+
+func TestGeneratePopulation_Reproducible(t *testing.T) {
+	scenario, err := LoadScenario("testdata/scenario.yaml")
+	if err != nil {
+		t.Fatalf("LoadScenario() error = %v", err)
+	}
+
+	first := generatePopulation(scenario, DefaultNamespace)
+	second := generatePopulation(scenario, DefaultNamespace)
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("expected the same seed to generate the same population")
+	}
+
+	if len(first.players) != 20 || len(first.events) == 0 {
+		t.Fatalf("expected 20 players with events, got %d players and %d events", len(first.players), len(first.events))
+	}
+	if first.events[0].at.Before(scenario.Start) {
+		t.Errorf("expected events from %v, got the first at %v", scenario.Start, first.events[0].at)
+	}
+	for i := 1; i < len(first.events); i++ {
+		if first.events[i].at.Before(first.events[i-1].at) {
+			t.Fatalf("event %d at %v is before the previous event at %v", i, first.events[i].at, first.events[i-1].at)
+		}
+	}
+}
+
+func TestSimulate_FollowsVirtualClock(t *testing.T) {
+	silenceLogs(t)
+
+	scenario, err := LoadScenario("testdata/scenario.yaml")
+	if err != nil {
+		t.Fatalf("LoadScenario() error = %v", err)
+	}
+	pipelineConfig, err := pipeline.LoadConfig("testdata/pipeline.yaml")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	pop := generatePopulation(scenario, DefaultNamespace)
+	result, err := simulate(context.Background(), scenario, pop, pipelineConfig, DefaultNamespace)
+	if err != nil {
+		t.Fatalf("simulate() error = %v", err)
+	}
+
+	if result.Events != len(pop.events) || result.FailedEvents != 0 {
+		t.Errorf("expected %d events without failures, got %d with %d failed", len(pop.events), result.Events, result.FailedEvents)
+	}
+
+	rageQuit := result.Rules["rage-quit"]
+	if rageQuit.Triggers == 0 || rageQuit.CooldownSuppressed == 0 {
+		t.Fatalf("expected rage-quit triggers and cooldown suppressions, got %+v", rageQuit)
+	}
+	// The week-long cooldown only expires in simulated time, which lets a player trigger
+	// again in a later week; under the wall clock every player would trigger once.
+	if rageQuit.Triggers <= len(rageQuit.Players) {
+		t.Errorf("expected players to trigger again after their cooldown, got %d triggers for %d players", rageQuit.Triggers, len(rageQuit.Players))
+	}
+	if rageQuit.Triggers > len(rageQuit.Players)*scenario.Weeks {
+		t.Errorf("expected at most one trigger per player per week, got %d triggers for %d players", rageQuit.Triggers, len(rageQuit.Players))
+	}
+
+	if losingStreak := result.Rules["losing-streak"]; losingStreak.Triggers != 0 || losingStreak.ShadowTriggers == 0 {
+		t.Errorf("expected only shadow triggers of the shadow rule, got %+v", losingStreak)
+	}
+
+	grantItem := result.Actions["grant-item"]
+	if grantItem.Executions != rageQuit.Triggers || grantItem.Shadowed != result.Rules["losing-streak"].ShadowTriggers {
+		t.Errorf("expected an execution per trigger and a shadow execution per shadow trigger, got %+v", grantItem)
+	}
+	if result.Cost != float64(grantItem.Executions)*2 {
+		t.Errorf("expected cost %v, got %v", float64(grantItem.Executions)*2, result.Cost)
+	}
+}
+
+func TestRun_Deterministic(t *testing.T) {
+	silenceLogs(t)
+
+	run := func() string {
+		t.Helper()
+		var out bytes.Buffer
+		err := Run(context.Background(), Options{
+			ScenarioPath: "testdata/scenario.yaml",
+			ConfigPaths:  []string{"testdata/pipeline.yaml", "testdata/pipeline.yaml"},
+		}, &out)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		return out.String()
+	}
+
+	first := run()
+	if !strings.Contains(first, "Simulated 20 players in 1 segments over 4 weeks from 2026-01-05 (seed 7)") {
+		t.Errorf("unexpected report header:\n%s", first)
+	}
+	if second := run(); second != first {
+		t.Errorf("expected identical reports for the same scenario, got:\n%s\nand:\n%s", first, second)
+	}
+}
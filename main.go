@@ -6,6 +6,7 @@ package main
 
 import (
 	"context"
+	"os"
 
 	"github.com/AccelByte/extend-churn-intervention/internal/app"
 	"github.com/AccelByte/extend-churn-intervention/internal/config"
@@ -13,6 +14,13 @@ import (
 )
 
 func main() {
+	// Offline sub-commands (see commands.go); without one, run the service
+	if len(os.Args) > 1 {
+		if command, ok := commands[os.Args[1]]; ok {
+			os.Exit(command(os.Args[2:]))
+		}
+	}
+
 	// Setup logging
 	logrus.SetFormatter(&logrus.JSONFormatter{})
 	logrus.SetLevel(logrus.InfoLevel)
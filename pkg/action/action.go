@@ -29,6 +29,13 @@ type Action interface {
 	Config() ActionConfig
 }
 
+// Describer is optionally implemented by actions to describe what Execute would do,
+// e.g. "grant item speed_booster (quantity: 1) to user abc". The description is
+// logged and recorded when the action runs in shadow mode.
+type Describer interface {
+	Describe(trigger *rule.Trigger, playerCtx *signal.PlayerContext) string
+}
+
 // ActionResult represents the outcome of an action execution.
 type ActionResult struct {
 	ActionID string
@@ -0,0 +1,182 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultAsyncWorkers is the default number of workers executing async actions.
+	DefaultAsyncWorkers = 4
+	// DefaultAsyncQueueSize is the default number of async actions that may wait for a worker.
+	DefaultAsyncQueueSize = 1000
+)
+
+var (
+	// ErrAsyncPoolClosed indicates that an async action was dispatched after shutdown.
+	ErrAsyncPoolClosed = errors.New("async action pool is closed")
+
+	// errAsyncQueueFull indicates that the async queue has no room for another action.
+	errAsyncQueueFull = errors.New("async action queue is full")
+)
+
+// AsyncConfig configures the worker pool that executes actions flagged `async: true`.
+// Async actions run off the request path, so slow calls (e.g. AGS platform APIs)
+// do not block the event handler.
+type AsyncConfig struct {
+	Workers   int // Number of concurrent workers
+	QueueSize int // Maximum async actions waiting for a worker
+}
+
+// asyncPool is a bounded worker pool for async actions.
+type asyncPool struct {
+	executor *Executor
+	jobs     chan *asyncJob
+	wg       sync.WaitGroup
+	mu       sync.RWMutex
+	closed   bool
+
+	// ctx is cancelled when a drain times out, aborting in-flight retries
+	// and discarding async actions that have not started yet.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// asyncJob is an async action waiting for a worker.
+type asyncJob struct {
+	ctx        context.Context
+	action     Action
+	trigger    *rule.Trigger
+	playerCtx  *signal.PlayerContext
+	enqueuedAt time.Time
+}
+
+// newAsyncPool starts the async workers.
+func newAsyncPool(executor *Executor, cfg AsyncConfig) *asyncPool {
+	if cfg.Workers <= 0 {
+		cfg.Workers = DefaultAsyncWorkers
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = DefaultAsyncQueueSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &asyncPool{
+		executor: executor,
+		jobs:     make(chan *asyncJob, cfg.QueueSize),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+
+	for i := 0; i < cfg.Workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+
+	return p
+}
+
+// work executes async actions until the queue is closed.
+func (p *asyncPool) work() {
+	defer p.wg.Done()
+
+	for job := range p.jobs {
+		metrics.AsyncActionQueueDepth.Set(float64(len(p.jobs)))
+
+		if p.ctx.Err() != nil {
+			logrus.Errorf("discarding async action %s for user %s: shutdown deadline exceeded",
+				job.action.ID(), job.trigger.UserID)
+			metrics.AsyncActionFailuresTotal.WithLabelValues(job.action.ID()).Inc()
+			continue
+		}
+
+		p.run(job)
+	}
+}
+
+// run executes a single async action with its retry policy.
+func (p *asyncPool) run(job *asyncJob) {
+	// The request context is cancelled once the event handler returns; keep its values
+	// (e.g. trace context) but tie cancellation to the pool instead.
+	ctx, cancel := context.WithCancel(context.WithoutCancel(job.ctx))
+	defer cancel()
+	stop := context.AfterFunc(p.ctx, cancel)
+	defer stop()
+
+	logrus.Infof("executing async action %s for trigger %s (user: %s, queued for %v)",
+		job.action.ID(), job.trigger.RuleID, job.trigger.UserID, time.Since(job.enqueuedAt))
+
+	attempts, err := p.executor.executeWithRetry(ctx, job.action, job.trigger, job.playerCtx)
+	if err != nil {
+		logrus.Errorf("async action %s failed after %d attempt(s): %v", job.action.ID(), attempts, err)
+		metrics.AsyncActionFailuresTotal.WithLabelValues(job.action.ID()).Inc()
+		return
+	}
+
+	logrus.Infof("async action %s completed successfully", job.action.ID())
+}
+
+// submit queues an async action without blocking.
+// Returns errAsyncQueueFull when no slot is free and ErrAsyncPoolClosed after shutdown.
+//
+// The job gets its own copy of the player's context: it runs outside the player's lane, so
+// the lane may process the player's next events meanwhile. Actions saving the copy go
+// through the churn state's revision check like any other writer (see
+// service.UpdateChurnStateWithRetry), so neither the lane's nor the job's writes are lost.
+func (p *asyncPool) submit(ctx context.Context, action Action, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+	job := &asyncJob{
+		ctx:        ctx,
+		action:     action,
+		trigger:    trigger,
+		playerCtx:  playerCtx.Clone(),
+		enqueuedAt: time.Now(),
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrAsyncPoolClosed
+	}
+
+	select {
+	case p.jobs <- job:
+		metrics.AsyncActionQueueDepth.Set(float64(len(p.jobs)))
+		return nil
+	default:
+		return errAsyncQueueFull
+	}
+}
+
+// shutdown stops accepting async actions and waits for queued ones to finish.
+// If ctx expires first, in-flight actions are cancelled and queued ones are discarded.
+func (p *asyncPool) shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.jobs)
+	}
+	p.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		p.cancel()
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		return ctx.Err()
+	}
+}
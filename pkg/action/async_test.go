@@ -0,0 +1,270 @@
+package action
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+)
+
+func newAsyncTestAction(id string, executeFunc func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error) *testAction {
+	return &testAction{
+		id:          id,
+		name:        id,
+		config:      ActionConfig{ID: id, Enabled: true, Async: true},
+		executeFunc: executeFunc,
+	}
+}
+
+func TestExecutor_Execute_AsyncRunsOffRequestPath(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+	executor.EnableAsync(AsyncConfig{Workers: 1, QueueSize: 10})
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+	registry.Register(newAsyncTestAction("async_action", func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+		<-release
+		close(done)
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	result, err := executor.Execute(ctx, "async_action", trigger, &signal.PlayerContext{UserID: "test-user"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !result.Success || result.Metadata["async"] != true {
+		t.Errorf("Expected successful async result, got %+v", result)
+	}
+
+	// The request context ending must not cancel the queued action
+	cancel()
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected async action to run")
+	}
+
+	if err := executor.Shutdown(context.Background()); err != nil {
+		t.Errorf("Unexpected shutdown error: %v", err)
+	}
+}
+
+func TestExecutor_Shutdown_DrainsAsyncActions(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+	executor.EnableAsync(AsyncConfig{Workers: 1, QueueSize: 10})
+
+	var completed int32
+	registry.Register(newAsyncTestAction("async_action", func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&completed, 1)
+		return nil
+	}))
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	for i := 0; i < 5; i++ {
+		if _, err := executor.Execute(context.Background(), "async_action", trigger, nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if err := executor.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Unexpected shutdown error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&completed); got != 5 {
+		t.Errorf("Expected 5 completed async actions after drain, got %d", got)
+	}
+}
+
+func TestExecutor_Shutdown_Timeout(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+	executor.EnableAsync(AsyncConfig{Workers: 1, QueueSize: 10})
+
+	started := make(chan struct{})
+	registry.Register(newAsyncTestAction("blocking_action", func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	if _, err := executor.Execute(context.Background(), "blocking_action", trigger, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := executor.Shutdown(ctx); err == nil {
+		t.Error("Expected shutdown to time out while an async action is blocked")
+	}
+}
+
+func TestExecutor_ExecuteMultiple_AsyncDeferredUntilSyncSucceed(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+	executor.EnableAsync(AsyncConfig{Workers: 1, QueueSize: 10})
+
+	var asyncCalls int32
+	registry.Register(newAsyncTestAction("async_action", func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+		atomic.AddInt32(&asyncCalls, 1)
+		return nil
+	}))
+
+	syncAction := &testAction{
+		id:     "sync_action",
+		name:   "Sync Action",
+		config: ActionConfig{ID: "sync_action", Enabled: true},
+	}
+	failingAction := &testAction{
+		id:     "failing_action",
+		name:   "Failing Action",
+		config: ActionConfig{ID: "failing_action", Enabled: true},
+		executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			return &testError{msg: "sync failure"}
+		},
+	}
+	registry.Register(syncAction)
+	registry.Register(failingAction)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+
+	// Async action listed first is still not dispatched when a sync action fails
+	_, err := executor.ExecuteMultiple(context.Background(), []string{"async_action", "sync_action", "failing_action"}, trigger, nil, true)
+	if err == nil {
+		t.Fatal("Expected error from failing sync action")
+	}
+
+	if !syncAction.rollbackCalled {
+		t.Error("Expected sync action to be rolled back")
+	}
+
+	results, err := executor.ExecuteMultiple(context.Background(), []string{"async_action", "sync_action"}, trigger, nil, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(results) != 2 || results[0].ActionID != "sync_action" || results[1].ActionID != "async_action" {
+		t.Errorf("Expected sync result followed by async result, got %+v", results)
+	}
+
+	if err := executor.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Unexpected shutdown error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&asyncCalls); got != 1 {
+		t.Errorf("Expected async action to run once, got %d", got)
+	}
+}
+
+func TestExecutor_Execute_AsyncFallsBackInline(t *testing.T) {
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+
+	t.Run("pool not enabled", func(t *testing.T) {
+		registry := NewRegistry()
+		executor := NewExecutor(registry)
+
+		called := false
+		registry.Register(newAsyncTestAction("async_action", func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			called = true
+			return nil
+		}))
+
+		if _, err := executor.Execute(context.Background(), "async_action", trigger, nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !called {
+			t.Error("Expected async action to run inline without a pool")
+		}
+	})
+
+	t.Run("pool closed", func(t *testing.T) {
+		registry := NewRegistry()
+		executor := NewExecutor(registry)
+		executor.EnableAsync(AsyncConfig{Workers: 1, QueueSize: 1})
+		if err := executor.Shutdown(context.Background()); err != nil {
+			t.Fatalf("Unexpected shutdown error: %v", err)
+		}
+
+		called := false
+		registry.Register(newAsyncTestAction("async_action", func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			called = true
+			return nil
+		}))
+
+		result, err := executor.Execute(context.Background(), "async_action", trigger, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !called || result.Metadata["async"] == true {
+			t.Error("Expected async action to run inline after shutdown")
+		}
+	})
+}
+
+func TestExecutor_Execute_AsyncActionGetsStateCopy(t *testing.T) {
+	ctx := namespace.NewContext(context.Background(), "test", "test")
+	store := service.NewMemoryChurnStateStore()
+	state, _ := store.GetChurnState(ctx, "test-user")
+	playerCtx := signal.BuildPlayerContext("test-user", "test", state, time.Now())
+
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+	executor.EnableAsync(AsyncConfig{Workers: 1, QueueSize: 10})
+
+	release := make(chan struct{})
+	var jobState *service.ChurnState
+	registry.Register(newAsyncTestAction("async_action", func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+		<-release
+		jobState = playerCtx.State
+		return service.UpdateChurnStateWithRetry(ctx, store, trigger.UserID, playerCtx.State, func(state *service.ChurnState) error {
+			state.AddIntervention("from-async", "grant_item", trigger.RuleID, nil, nil, time.Now())
+			return nil
+		})
+	}))
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	if _, err := executor.Execute(ctx, "async_action", trigger, playerCtx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The lane processes the player's next event while the action is queued
+	state.AddIntervention("from-lane", "grant_item", "other_rule", nil, nil, time.Now())
+	if err := store.UpdateChurnState(ctx, "test-user", state); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	close(release)
+	if err := executor.Shutdown(ctx); err != nil {
+		t.Fatalf("Unexpected shutdown error: %v", err)
+	}
+
+	if jobState == state {
+		t.Error("Expected the async action to get its own copy of the state")
+	}
+	if state.GetInterventionByID("from-async") != nil {
+		t.Error("Expected the async action not to modify the lane's state")
+	}
+
+	// Both writes are kept
+	stored, _ := store.GetChurnState(ctx, "test-user")
+	if stored.GetInterventionByID("from-lane") == nil || stored.GetInterventionByID("from-async") == nil {
+		t.Errorf("Expected both interventions to be persisted, got %+v", stored.InterventionHistory)
+	}
+}
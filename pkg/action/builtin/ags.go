@@ -0,0 +1,39 @@
+package builtin
+
+import (
+	"errors"
+	"net"
+	"regexp"
+	"strconv"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+)
+
+// agsStatusPattern matches the status code in the errors AGS clients return for
+// responses their API does not declare, e.g. "... returns an error 503: ...".
+var agsStatusPattern = regexp.MustCompile(`returns an error (\d{3})`)
+
+// agsError classifies the error of an AGS call for the executor's retry policy.
+// Requests that did not reach AGS and 5xx responses are retryable. Timeouts are not:
+// AGS may have applied the request before the response was lost.
+func agsError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return err
+		}
+		return action.Retryable(err)
+	}
+
+	if match := agsStatusPattern.FindStringSubmatch(err.Error()); match != nil {
+		if code, _ := strconv.Atoi(match[1]); code >= 500 {
+			return action.Retryable(err)
+		}
+	}
+
+	return err
+}
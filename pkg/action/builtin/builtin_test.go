@@ -2,6 +2,9 @@ package builtin
 
 import (
 	"context"
+	"errors"
+	"net"
+	"net/url"
 	"testing"
 	"time"
 
@@ -26,6 +29,10 @@ func (m *mockStateStore) UpdateChurnState(ctx context.Context, userID string, st
 	return m.updateError
 }
 
+func (m *mockStateStore) DeleteChurnState(ctx context.Context, userID string) error {
+	return nil
+}
+
 // mockEntitlementGranter is a mock implementation for testing
 type mockEntitlementGranter struct {
 	grantCalled bool
@@ -108,7 +115,7 @@ func TestComebackChallengeAction_Execute(t *testing.T) {
 	}
 
 	// Check cooldown was set
-	if !playerState.Cooldown.IsOnCooldown() {
+	if !playerState.Cooldown.IsOnCooldown(time.Now()) {
 		t.Error("Expected cooldown to be set")
 	}
 
@@ -117,6 +124,41 @@ func TestComebackChallengeAction_Execute(t *testing.T) {
 	}
 }
 
+func TestComebackChallengeAction_Execute_RetriedAfterStatUpdateFailure(t *testing.T) {
+	mockStatUpdater := &mockUserStatUpdater{
+		updateError: errors.New("Requested PUT /social/v2/admin/namespaces/{namespace}/users/{userId}/statitems/value/bulk returns an error 502: bad gateway"),
+	}
+	config := action.ActionConfig{ID: "test_challenge", Type: ComebackChallengeActionID, Enabled: true}
+	act := NewDispatchComebackChallengeAction(config, &mockStateStore{}, mockStatUpdater)
+
+	playerState := &service.ChurnState{
+		Cooldown: service.CooldownState{
+			InterventionCounts: make(map[string]int),
+			LastSignalAt:       make(map[string]time.Time),
+		},
+	}
+	playerCtx := &signal.PlayerContext{UserID: "test-user", State: playerState}
+	trigger := rule.NewTrigger("rage_quit", "test-user", "rage quit detected", 10)
+
+	err := act.Execute(context.Background(), trigger, playerCtx)
+	if !action.IsRetryable(err) {
+		t.Fatalf("expected a retryable error, got %v", err)
+	}
+
+	mockStatUpdater.updateCalled = false
+	mockStatUpdater.updateError = nil
+	if err := act.Execute(context.Background(), trigger, playerCtx); err != nil {
+		t.Fatalf("Unexpected error on retry: %v", err)
+	}
+
+	if !mockStatUpdater.updateCalled {
+		t.Error("Expected the retry to update the stat")
+	}
+	if len(playerState.InterventionHistory) != 1 {
+		t.Errorf("Expected 1 intervention, got %d", len(playerState.InterventionHistory))
+	}
+}
+
 func TestComebackChallengeAction_Execute_ChallengeAlreadyActive(t *testing.T) {
 	mockStore := &mockStateStore{}
 	config := action.ActionConfig{
@@ -238,7 +280,7 @@ func TestComebackChallengeAction_Rollback(t *testing.T) {
 	}
 
 	// Check cooldown was reset
-	if playerState.Cooldown.IsOnCooldown() {
+	if playerState.Cooldown.IsOnCooldown(time.Now()) {
 		t.Error("Expected cooldown to be reset after rollback")
 	}
 
@@ -285,6 +327,37 @@ func TestGrantItemAction_Execute(t *testing.T) {
 	}
 }
 
+func TestGrantItemAction_Execute_RetryableErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"connection refused", &url.Error{Op: "Post", URL: "https://ags", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, true},
+		{"server error", errors.New("Requested POST /platform/admin/namespaces/{namespace}/users/{userId}/fulfillment returns an error 503: unavailable"), true},
+		{"timeout", &url.Error{Op: "Post", URL: "https://ags", Err: &net.DNSError{IsTimeout: true}}, false},
+		{"client error", errors.New("Requested POST /platform/admin/namespaces/{namespace}/users/{userId}/fulfillment returns an error 422: invalid"), false},
+		{"unclassified error", errors.New("unexpected response"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := action.ActionConfig{ID: "test_grant", Type: GrantItemActionID, Enabled: true,
+				Parameters: map[string]interface{}{"item_id": "speed_booster"}}
+			act := NewGrantItemAction(config, &mockEntitlementGranter{grantError: tt.err}, "test-namespace")
+
+			trigger := rule.NewTrigger("challenge_complete", "test-user", "challenge completed", 10)
+			err := act.Execute(context.Background(), trigger, &signal.PlayerContext{UserID: "test-user"})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected the grant error, got %v", err)
+			}
+			if got := action.IsRetryable(err); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.retryable)
+			}
+		})
+	}
+}
+
 func TestGrantItemAction_Execute_TestMode(t *testing.T) {
 	config := action.ActionConfig{
 		ID:      "test_grant",
@@ -296,8 +369,11 @@ func TestGrantItemAction_Execute_TestMode(t *testing.T) {
 		},
 	}
 
-	// No granter provided (test mode)
-	act := NewGrantItemAction(config, nil, "test-namespace")
+	// No granter provided (test mode), so the executor only records the grant
+	registry := action.NewRegistry()
+	if err := registry.Register(NewGrantItemAction(config, nil, "test-namespace")); err != nil {
+		t.Fatalf("Failed to register action: %v", err)
+	}
 
 	trigger := rule.NewTrigger("challenge_complete", "test-user", "challenge completed", 10)
 	playerCtx := &signal.PlayerContext{
@@ -305,10 +381,34 @@ func TestGrantItemAction_Execute_TestMode(t *testing.T) {
 		State:  &service.ChurnState{},
 	}
 
-	err := act.Execute(context.Background(), trigger, playerCtx)
+	result, err := action.NewExecutor(registry).Execute(context.Background(), "test_grant", trigger, playerCtx)
 	if err != nil {
 		t.Fatalf("Unexpected error in test mode: %v", err)
 	}
+	if shadow, _ := result.Metadata["shadow"].(bool); !shadow {
+		t.Errorf("Expected the grant to be recorded in shadow mode, got %+v", result)
+	}
+}
+
+func TestGrantItemAction_NoGranterRunsInShadowMode(t *testing.T) {
+	config := action.ActionConfig{
+		ID:         "test_grant",
+		Type:       GrantItemActionID,
+		Enabled:    true,
+		Parameters: map[string]interface{}{"item_id": "speed_booster", "quantity": 2},
+	}
+
+	act := NewGrantItemAction(config, nil, "test-namespace")
+	actConfig := act.Config()
+	if !actConfig.IsShadow() {
+		t.Error("Expected action without granter to run in shadow mode")
+	}
+
+	trigger := rule.NewTrigger("challenge_complete", "test-user", "challenge completed", 10)
+	want := "grant item speed_booster (quantity: 2) to user test-user"
+	if got := act.Describe(trigger, nil); got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
 }
 
 func TestGrantItemAction_Execute_NoItemID(t *testing.T) {
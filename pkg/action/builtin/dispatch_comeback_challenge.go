@@ -2,9 +2,12 @@ package builtin
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
@@ -21,6 +24,24 @@ const (
 	DefaultCooldownHours = 48
 )
 
+// errInterventionSkipped aborts a state update that has nothing to change,
+// e.g. because the player received an intervention in the meantime.
+var errInterventionSkipped = errors.New("intervention skipped")
+
+// errInterventionRecorded aborts a state update whose intervention was already saved
+// by a previous attempt of the same trigger, e.g. when the action is retried.
+var errInterventionRecorded = errors.New("intervention already recorded")
+
+// ComebackChallengeParams is the parameter schema of the comeback challenge action.
+var ComebackChallengeParams = param.Schema{
+	{Name: "wins_needed", Type: param.TypeInt, Default: DefaultWinsNeeded, Min: param.Bound(1),
+		Description: "Wins needed to complete the challenge"},
+	{Name: "duration_days", Type: param.TypeInt, Default: DefaultDurationDays, Min: param.Bound(1),
+		Description: "Days the player has to complete the challenge"},
+	{Name: "cooldown_hours", Type: param.TypeInt, Default: DefaultCooldownHours, Min: param.Bound(0),
+		Description: "Hours before the player can receive another intervention"},
+}
+
 // DispatchComebackChallengeAction creates a comeback challenge for at-risk players.
 // This action creates a time-limited challenge requiring a certain number of wins.
 type DispatchComebackChallengeAction struct {
@@ -71,105 +92,133 @@ func (a *DispatchComebackChallengeAction) Config() action.ActionConfig {
 }
 
 // Execute creates a comeback challenge intervention for the player.
+// The intervention is saved before the challenge stat is updated, so that a concurrent
+// event that intervened first makes this execution skip rather than dispatch a second challenge.
 func (a *DispatchComebackChallengeAction) Execute(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
 	if playerCtx == nil || playerCtx.State == nil {
 		return action.ErrMissingPlayerContext
 	}
 
-	now := time.Now()
-	playerState := playerCtx.State
+	var interventionID string
+	var expiresAt time.Time
+	err := a.updateState(ctx, trigger.UserID, playerCtx.State, func(playerState *service.ChurnState) error {
+		now := trigger.Timestamp
+		interventionID = trigger.UserID + "-comeback-" + now.Format("20060102150405")
 
-	// Check if we're on cooldown
-	if playerState.Cooldown.IsOnCooldown() {
-		logrus.Warnf("intervention on cooldown for user %s, skipping", trigger.UserID)
-		return nil
-	}
+		// A previous attempt saved the intervention but failed to dispatch the challenge
+		if recorded := playerState.GetInterventionByID(interventionID); recorded != nil {
+			if recorded.ExpiresAt != nil {
+				expiresAt = *recorded.ExpiresAt
+			}
+			return errInterventionRecorded
+		}
 
-	// Check if there's already an active comeback challenge intervention
-	activeInterventions := playerState.GetActiveInterventions()
-	for _, intervention := range activeInterventions {
-		if intervention.Type == ComebackChallengeActionID {
-			logrus.Warnf("comeback challenge already active for user %s, skipping creation", trigger.UserID)
-			return nil
+		// Check if we're on cooldown
+		if playerState.Cooldown.IsOnCooldown(now) {
+			logrus.Warnf("intervention on cooldown for user %s, skipping", trigger.UserID)
+			return errInterventionSkipped
 		}
-	}
 
-	// Calculate expiration time
-	expiresAt := now.Add(time.Duration(a.durationDays) * 24 * time.Hour)
+		// Check if there's already an active comeback challenge intervention
+		activeInterventions := playerState.GetActiveInterventions(now)
+		for _, intervention := range activeInterventions {
+			if intervention.Type == ComebackChallengeActionID {
+				logrus.Warnf("comeback challenge already active for user %s, skipping creation", trigger.UserID)
+				return errInterventionSkipped
+			}
+		}
 
-	// Record the intervention
-	interventionID := trigger.UserID + "-comeback-" + now.Format("20060102150405")
-	metadata := map[string]interface{}{
-		"wins_needed":     a.winsNeeded,
-		"duration_days":   a.durationDays,
-		"trigger_rule_id": trigger.RuleID,
-	}
+		// Calculate expiration time
+		expiresAt = now.Add(time.Duration(a.durationDays) * 24 * time.Hour)
+
+		// Record the intervention
+		metadata := map[string]interface{}{
+			"wins_needed":     a.winsNeeded,
+			"duration_days":   a.durationDays,
+			"trigger_rule_id": trigger.RuleID,
+		}
+		if matchID, ok := trigger.Metadata[signal.MetadataMatchID]; ok {
+			metadata["trigger_match_id"] = matchID
+		}
 
-	playerState.AddIntervention(interventionID, ComebackChallengeActionID, trigger.RuleID, &expiresAt, metadata)
+		playerState.AddIntervention(interventionID, ComebackChallengeActionID, trigger.RuleID, &expiresAt, metadata, now)
 
-	// Set cooldown
-	cooldownDuration := time.Duration(a.cooldownHours) * time.Hour
-	playerState.Cooldown.CooldownUntil = now.Add(cooldownDuration)
+		// Set cooldown
+		cooldownDuration := time.Duration(a.cooldownHours) * time.Hour
+		playerState.Cooldown.CooldownUntil = now.Add(cooldownDuration)
+		return nil
+	})
+	if errors.Is(err, errInterventionSkipped) {
+		return nil
+	}
+	if err != nil && !errors.Is(err, errInterventionRecorded) {
+		logrus.Errorf("failed to save player state after intervention: %v", err)
+		return err
+	}
 
 	logrus.Infof("created comeback challenge intervention for user %s: id=%s, winsNeeded=%d, expiresAt=%v, reason=%s",
 		trigger.UserID, interventionID, a.winsNeeded, expiresAt, trigger.RuleID)
 
-	// Save updated state
-	if a.stateStore != nil {
-		err := a.stateStore.UpdateChurnState(ctx, trigger.UserID, playerState)
-		if err != nil {
-			logrus.Errorf("failed to save player state after intervention: %v", err)
-			return err
-		}
-	}
-
 	// This example action utilize extend-challenge-event-handler, which listens for a specific stat update to trigger challenge for a user.
 	// The stat `rse-comeback-challenge` will be updated and listened by the extend-challenge-event-handler to trigger the challenge for the user.
-	err := a.userStatUpdater.UpdateStatComebackChallenge(ctx, trigger.UserID)
+	err = a.userStatUpdater.UpdateStatComebackChallenge(ctx, trigger.UserID)
 	if err != nil {
 		logrus.Errorf("failed to update user statistics: %v", err)
-		return err
+		return agsError(err)
 	}
 
 	return nil
 }
 
+// updateState applies update to the player's state and saves it, reapplying the update
+// if the state was saved concurrently. Without a state store the state is only updated in memory.
+func (a *DispatchComebackChallengeAction) updateState(ctx context.Context, userID string, state *service.ChurnState, update func(*service.ChurnState) error) error {
+	if a.stateStore == nil {
+		return update(state)
+	}
+	return service.UpdateChurnStateWithRetry(ctx, a.stateStore, userID, state, update)
+}
+
+// Describe describes the challenge for shadow mode.
+func (a *DispatchComebackChallengeAction) Describe(trigger *rule.Trigger, playerCtx *signal.PlayerContext) string {
+	return fmt.Sprintf("create comeback challenge (wins needed: %d, duration: %d days) for user %s",
+		a.winsNeeded, a.durationDays, trigger.UserID)
+}
+
 // Rollback marks the intervention as failed (if possible).
 func (a *DispatchComebackChallengeAction) Rollback(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
 	if playerCtx == nil || playerCtx.State == nil {
 		return action.ErrMissingPlayerContext
 	}
 
-	playerState := playerCtx.State
-
-	// Find active comeback challenge interventions triggered by this rule
-	activeInterventions := playerState.GetActiveInterventions()
-	for _, intervention := range activeInterventions {
-		if intervention.Type == ComebackChallengeActionID && intervention.TriggeredBy == trigger.RuleID {
-			logrus.Infof("rolling back intervention %s for user %s (reason: %s)", intervention.ID, trigger.UserID, trigger.RuleID)
+	err := a.updateState(ctx, trigger.UserID, playerCtx.State, func(playerState *service.ChurnState) error {
+		// Find active comeback challenge interventions triggered by this rule
+		activeInterventions := playerState.GetActiveInterventions(trigger.Timestamp)
+		for _, intervention := range activeInterventions {
+			if intervention.Type == ComebackChallengeActionID && intervention.TriggeredBy == trigger.RuleID {
+				logrus.Infof("rolling back intervention %s for user %s (reason: %s)", intervention.ID, trigger.UserID, trigger.RuleID)
 
-			// Mark intervention as failed
-			playerState.UpdateInterventionOutcome(intervention.ID, "failed")
+				// Mark intervention as failed
+				playerState.UpdateInterventionOutcome(intervention.ID, "failed", trigger.Timestamp)
 
-			// Reset cooldown
-			playerState.Cooldown.CooldownUntil = time.Time{}
+				// Reset cooldown
+				playerState.Cooldown.CooldownUntil = time.Time{}
 
-			// TODO revert the challenge creation if possible
-			// (e.g., by sending a cancellation event to the challenge service)
-
-			// Save updated state
-			if a.stateStore != nil {
-				err := a.stateStore.UpdateChurnState(ctx, trigger.UserID, playerState)
-				if err != nil {
-					logrus.Errorf("failed to save player state after rollback: %v", err)
-					return err
-				}
+				// TODO revert the challenge creation if possible
+				// (e.g., by sending a cancellation event to the challenge service)
+				return nil
 			}
-
-			return nil
 		}
+		return errInterventionSkipped
+	})
+	if errors.Is(err, errInterventionSkipped) {
+		logrus.Warnf("cannot rollback intervention for user %s: no active intervention created by this trigger", trigger.UserID)
+		return nil
+	}
+	if err != nil {
+		logrus.Errorf("failed to save player state after rollback: %v", err)
+		return err
 	}
 
-	logrus.Warnf("cannot rollback intervention for user %s: no active intervention created by this trigger", trigger.UserID)
 	return nil
 }
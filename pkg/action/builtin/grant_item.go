@@ -10,6 +10,7 @@ import (
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/repository"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
 	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
@@ -21,6 +22,14 @@ const (
 	GrantItemActionID = "grant_item"
 )
 
+// GrantItemParams is the parameter schema of the grant item action.
+var GrantItemParams = param.Schema{
+	{Name: "item_id", Type: param.TypeString, Required: true,
+		Description: "Item ID of the entitlement to grant"},
+	{Name: "quantity", Type: param.TypeInt, Default: 1, Min: param.Bound(1),
+		Description: "Number of items to grant"},
+}
+
 // ItemGranter is an interface for granting items to users.
 // This allows for dependency injection and testing.
 type ItemGranter interface {
@@ -38,12 +47,18 @@ type GrantItemAction struct {
 }
 
 // NewGrantItemAction creates a new grant item action.
+// Without a granter (e.g. in local testing) the action runs in shadow mode.
 func NewGrantItemAction(config action.ActionConfig, granter service.EntitlementGranter, namespace string) *GrantItemAction {
 	itemID := config.GetParameterString("item_id", "")
 	quantity := int32(config.GetParameterInt("quantity", 1))
 
 	logrus.Infof("creating grant item action: itemID=%s, quantity=%d", itemID, quantity)
 
+	if granter == nil {
+		logrus.Warnf("no entitlement granter configured, running grant item action %s in shadow mode", config.ID)
+		config.Mode = rule.ModeShadow
+	}
+
 	return &GrantItemAction{
 		config:    config,
 		granter:   granter,
@@ -74,24 +89,23 @@ func (a *GrantItemAction) Execute(ctx context.Context, trigger *rule.Trigger, pl
 		return fmt.Errorf("item_id parameter not configured")
 	}
 
-	if a.granter == nil {
-		logrus.Warnf("[TEST MODE] would grant item %s (quantity: %d) to user %s",
-			a.itemID, a.quantity, trigger.UserID)
-		return nil
-	}
-
 	logrus.Infof("granting item %s (quantity: %d) to user %s",
 		a.itemID, a.quantity, trigger.UserID)
 
 	err := a.granter.GrantEntitlement(ctx, trigger.UserID, a.itemID, int(a.quantity))
 	if err != nil {
-		return fmt.Errorf("failed to grant item: %w", err)
+		return agsError(fmt.Errorf("failed to grant item: %w", err))
 	}
 
 	logrus.Infof("successfully granted item %s to user %s", a.itemID, trigger.UserID)
 	return nil
 }
 
+// Describe describes the grant for shadow mode.
+func (a *GrantItemAction) Describe(trigger *rule.Trigger, playerCtx *signal.PlayerContext) string {
+	return fmt.Sprintf("grant item %s (quantity: %d) to user %s", a.itemID, a.quantity, trigger.UserID)
+}
+
 // Rollback is not supported for item grants (items cannot be taken back).
 func (a *GrantItemAction) Rollback(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
 	return action.ErrRollbackNotSupported
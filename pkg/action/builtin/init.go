@@ -23,17 +23,17 @@ func init() {
 // RegisterActions registers built-in action factories with dependencies.
 func RegisterActions(deps *Dependencies) {
 	// Register comeback challenge action
-	action.RegisterActionType(ComebackChallengeActionID, func(config action.ActionConfig) (action.Action, error) {
+	action.RegisterActionTypeWithSchema(ComebackChallengeActionID, ComebackChallengeParams, func(config action.ActionConfig) (action.Action, error) {
 		return NewDispatchComebackChallengeAction(config, deps.StateStore, deps.UserStatUpdater), nil
 	})
 
 	// Register grant item action
-	action.RegisterActionType(GrantItemActionID, func(config action.ActionConfig) (action.Action, error) {
+	action.RegisterActionTypeWithSchema(GrantItemActionID, GrantItemParams, func(config action.ActionConfig) (action.Action, error) {
 		return NewGrantItemAction(config, deps.EntitlementGranter, deps.Namespace), nil
 	})
 
 	// Register send email notification action
-	action.RegisterActionType(SendEmailActionID, func(config action.ActionConfig) (action.Action, error) {
+	action.RegisterActionTypeWithSchema(SendEmailActionID, SendEmailParams, func(config action.ActionConfig) (action.Action, error) {
 		return NewSendEmailAction(config), nil
 	})
 }
@@ -2,8 +2,10 @@ package builtin
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	"github.com/sirupsen/logrus"
@@ -14,6 +16,9 @@ const (
 	SendEmailActionID = "send_email_notification_after_granting_item"
 )
 
+// SendEmailParams is the parameter schema of the send email action, which takes none.
+var SendEmailParams = param.Schema{}
+
 // SendEmailAction sends an email notification to a player.
 // This is a no-op placeholder for email integration.
 type SendEmailAction struct {
@@ -49,6 +54,11 @@ func (a *SendEmailAction) Execute(ctx context.Context, trigger *rule.Trigger, pl
 	return nil
 }
 
+// Describe describes the notification for shadow mode.
+func (a *SendEmailAction) Describe(trigger *rule.Trigger, playerCtx *signal.PlayerContext) string {
+	return fmt.Sprintf("send email notification about granted item to user %s", trigger.UserID)
+}
+
 func (a *SendEmailAction) Rollback(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
 	return action.ErrRollbackNotSupported
 }
@@ -1,6 +1,10 @@
 package action
 
-import "time"
+import (
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+)
 
 // ActionConfig is the base configuration for all actions.
 // This is typically loaded from YAML configuration files.
@@ -9,15 +13,22 @@ type ActionConfig struct {
 	Type       string                 `yaml:"type" json:"type"` // e.g., "builtin.create_challenge"
 	Enabled    bool                   `yaml:"enabled" json:"enabled"`
 	Async      bool                   `yaml:"async" json:"async"`
+	Mode       string                 `yaml:"mode" json:"mode"` // "live" (default) or "shadow"
 	Retry      *RetryConfig           `yaml:"retry,omitempty" json:"retry,omitempty"`
 	Parameters map[string]interface{} `yaml:"parameters" json:"parameters"`
 }
 
+// IsShadow reports whether the action runs in shadow mode (rule.ModeShadow), in which it
+// records what it would have done without executing it.
+func (c *ActionConfig) IsShadow() bool {
+	return c.Mode == rule.ModeShadow
+}
+
 // RetryConfig defines retry behavior for failed actions.
 type RetryConfig struct {
 	MaxAttempts int           `yaml:"max_attempts" json:"max_attempts"`
 	Delay       time.Duration `yaml:"delay" json:"delay"`
-	Backoff     string        `yaml:"backoff" json:"backoff"` // "linear", "exponential"
+	Backoff     string        `yaml:"backoff" json:"backoff"` // "constant" (default), "linear", "exponential"
 }
 
 // GetParameterInt retrieves an integer parameter with a default.
@@ -30,11 +41,14 @@ func (c *ActionConfig) GetParameterInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
-// GetParameterFloat retrieves a float parameter with a default.
+// GetParameterFloat retrieves a float parameter with a default. Integers are converted.
 func (c *ActionConfig) GetParameterFloat(key string, defaultValue float64) float64 {
 	if val, ok := c.Parameters[key]; ok {
-		if floatVal, ok := val.(float64); ok {
-			return floatVal
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v) // YAML decodes whole numbers such as "1" as int
 		}
 	}
 	return defaultValue
@@ -1,6 +1,9 @@
 package action
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrRollbackNotSupported indicates that an action doesn't support rollback.
@@ -21,3 +24,41 @@ var (
 	// ErrMissingPlayerContext indicates that required player context is missing.
 	ErrMissingPlayerContext = errors.New("missing player context")
 )
+
+// ErrPermanent marks an action failure that will not succeed on retry.
+// Wrap errors with Permanent to opt out of the action's retry policy.
+var ErrPermanent = errors.New("permanent action failure")
+
+// Permanent wraps err so that the executor does not retry it.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrPermanent, err)
+}
+
+// Retryable wraps err so that the executor retries it according to the action's retry policy.
+// Only wrap failures after which executing the action again cannot repeat its side effects,
+// e.g. a request that did not reach the remote service.
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+// RetryableError is implemented by errors that know whether retrying may succeed,
+// e.g. an API error that is retryable for 5xx responses but not for 4xx responses.
+type RetryableError interface {
+	error
+	Retryable() bool
+}
+
+// retryableError is the RetryableError returned by Retryable.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string   { return e.err.Error() }
+func (e *retryableError) Unwrap() error   { return e.err }
+func (e *retryableError) Retryable() bool { return true }
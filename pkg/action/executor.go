@@ -2,17 +2,30 @@ package action
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer creates a span per action execution using the global tracer provider.
+var tracer = otel.Tracer("github.com/AccelByte/extend-churn-intervention/pkg/action")
+
 // Executor executes actions in response to rule triggers.
 type Executor struct {
 	registry *Registry
+	async    *asyncPool
+	stats    *executorStats
 	mu       sync.RWMutex
 }
 
@@ -20,33 +33,84 @@ type Executor struct {
 func NewExecutor(registry *Registry) *Executor {
 	return &Executor{
 		registry: registry,
+		stats:    newExecutorStats(),
+	}
+}
+
+// EnableAsync starts a worker pool for actions configured with `async: true`.
+// Without it, async actions run inline like any other action.
+// Call Shutdown to drain the pool.
+func (e *Executor) EnableAsync(cfg AsyncConfig) {
+	e.async = newAsyncPool(e, cfg)
+}
+
+// WithRegistry returns a new executor running the actions in registry.
+// The new executor shares this executor's async pool and statistics, so async actions
+// queued before a configuration reload keep running and are drained by either
+// executor's Shutdown, and counters survive the reload.
+func (e *Executor) WithRegistry(registry *Registry) *Executor {
+	return &Executor{
+		registry: registry,
+		async:    e.async,
+		stats:    e.stats,
 	}
 }
 
+// Stats returns a snapshot of the action execution counters.
+func (e *Executor) Stats() ExecutorStats {
+	return e.stats.snapshot()
+}
+
+// Shutdown stops accepting async actions and waits for queued ones to finish.
+func (e *Executor) Shutdown(ctx context.Context) error {
+	if e.async == nil {
+		return nil
+	}
+	return e.async.shutdown(ctx)
+}
+
 // Execute runs an action in response to a trigger.
+// Async actions are queued and reported as successful once dispatched.
+// Shadow actions, and all actions of shadow triggers, are only recorded (see executeShadow).
 func (e *Executor) Execute(ctx context.Context, actionID string, trigger *rule.Trigger, playerCtx *signal.PlayerContext) (*ActionResult, error) {
 	action := e.registry.Get(actionID)
 	if action == nil {
 		return nil, fmt.Errorf("action not found: %s", actionID)
 	}
 
+	if isShadow(action, trigger) {
+		return e.executeShadow(ctx, action, trigger, playerCtx), nil
+	}
+
+	if action.Config().Async {
+		return e.dispatchAsync(ctx, action, trigger, playerCtx)
+	}
+
 	logrus.Infof("executing action %s for trigger %s (user: %s)", actionID, trigger.RuleID, trigger.UserID)
 
-	err := action.Execute(ctx, trigger, playerCtx)
+	attempts, err := e.executeWithRetry(ctx, action, trigger, playerCtx)
 	if err != nil {
 		logrus.Errorf("action %s failed: %v", actionID, err)
-		return NewActionError(actionID, err), err
+		return NewActionError(actionID, err).WithMetadata("attempts", attempts), err
 	}
 
 	logrus.Infof("action %s completed successfully", actionID)
-	return NewActionResult(actionID), nil
+	return NewActionResult(actionID).WithMetadata("attempts", attempts), nil
 }
 
 // ExecuteMultiple executes multiple actions in sequence.
 // If rollbackOnError is true, previously executed actions will be rolled back if a later action fails.
+//
+// Async actions are deferred until every synchronous action has succeeded and are then
+// dispatched to the async pool, so their results follow the synchronous results.
+// If a synchronous action fails, deferred async actions are not dispatched at all.
+// Async actions never take part in rollback: they are not rolled back, and their
+// failures (reported only through logs and metrics) do not roll back other actions.
+// Shadow actions are recorded in order and never fail or take part in rollback.
 func (e *Executor) ExecuteMultiple(ctx context.Context, actionIDs []string, trigger *rule.Trigger, playerCtx *signal.PlayerContext, rollbackOnError bool) ([]*ActionResult, error) {
 	var results []*ActionResult
 	var executedActions []Action
+	var asyncActions []Action
 
 	for _, actionID := range actionIDs {
 		action := e.registry.Get(actionID)
@@ -61,12 +125,22 @@ func (e *Executor) ExecuteMultiple(ctx context.Context, actionIDs []string, trig
 			return results, err
 		}
 
+		if isShadow(action, trigger) {
+			results = append(results, e.executeShadow(ctx, action, trigger, playerCtx))
+			continue
+		}
+
+		if action.Config().Async {
+			asyncActions = append(asyncActions, action)
+			continue
+		}
+
 		logrus.Infof("executing action %s for trigger %s (user: %s)", actionID, trigger.RuleID, trigger.UserID)
 
-		err := action.Execute(ctx, trigger, playerCtx)
+		attempts, err := e.executeWithRetry(ctx, action, trigger, playerCtx)
 		if err != nil {
 			logrus.Errorf("action %s failed: %v", actionID, err)
-			results = append(results, NewActionError(actionID, err))
+			results = append(results, NewActionError(actionID, err).WithMetadata("attempts", attempts))
 
 			if rollbackOnError && len(executedActions) > 0 {
 				e.rollbackActions(ctx, executedActions, trigger, playerCtx)
@@ -76,13 +150,139 @@ func (e *Executor) ExecuteMultiple(ctx context.Context, actionIDs []string, trig
 		}
 
 		executedActions = append(executedActions, action)
-		results = append(results, NewActionResult(actionID))
+		results = append(results, NewActionResult(actionID).WithMetadata("attempts", attempts))
 		logrus.Infof("action %s completed successfully", actionID)
 	}
 
+	for _, action := range asyncActions {
+		result, _ := e.dispatchAsync(ctx, action, trigger, playerCtx)
+		results = append(results, result)
+	}
+
 	return results, nil
 }
 
+// dispatchAsync queues an async action on the pool. When no pool is enabled, the pool
+// is closed, or its queue is full, the action runs inline instead so that it is never
+// dropped; a full queue therefore applies backpressure to the caller.
+func (e *Executor) dispatchAsync(ctx context.Context, action Action, trigger *rule.Trigger, playerCtx *signal.PlayerContext) (*ActionResult, error) {
+	if e.async != nil {
+		err := e.async.submit(ctx, action, trigger, playerCtx)
+		if err == nil {
+			logrus.Infof("queued async action %s for trigger %s (user: %s)", action.ID(), trigger.RuleID, trigger.UserID)
+			return NewActionResult(action.ID()).WithMetadata("async", true), nil
+		}
+
+		if errors.Is(err, errAsyncQueueFull) {
+			metrics.AsyncActionQueueFullTotal.WithLabelValues(action.ID()).Inc()
+		}
+		logrus.Warnf("cannot queue async action %s, executing inline: %v", action.ID(), err)
+	}
+
+	logrus.Infof("executing action %s for trigger %s (user: %s)", action.ID(), trigger.RuleID, trigger.UserID)
+
+	attempts, err := e.executeWithRetry(ctx, action, trigger, playerCtx)
+	if err != nil {
+		logrus.Errorf("action %s failed: %v", action.ID(), err)
+		return NewActionError(action.ID(), err).WithMetadata("attempts", attempts), err
+	}
+
+	logrus.Infof("action %s completed successfully", action.ID())
+	return NewActionResult(action.ID()).WithMetadata("attempts", attempts), nil
+}
+
+// isShadow reports whether an action must only be recorded rather than executed:
+// either the action itself or the rule that triggered it runs in shadow mode.
+func isShadow(action Action, trigger *rule.Trigger) bool {
+	config := action.Config()
+	return trigger.Shadow || config.IsShadow()
+}
+
+// executeShadow records what an action would have done without executing it.
+// The result carries "shadow": true and, for actions implementing Describer, the
+// description under "would", so callers can keep shadow outcomes apart from live ones.
+func (e *Executor) executeShadow(ctx context.Context, action Action, trigger *rule.Trigger, playerCtx *signal.PlayerContext) *ActionResult {
+	_, span := tracer.Start(ctx, "action.shadow", trace.WithAttributes(
+		attribute.String("action.id", action.ID()),
+		attribute.String("rule.id", trigger.RuleID),
+		attribute.String("user.id", trigger.UserID),
+	))
+	defer span.End()
+
+	description := fmt.Sprintf("execute action %s", action.ID())
+	if describer, ok := action.(Describer); ok {
+		description = describer.Describe(trigger, playerCtx)
+	}
+
+	logrus.Infof("[SHADOW] action %s for trigger %s would %s", action.ID(), trigger.RuleID, description)
+	metrics.ShadowActionExecutionsTotal.WithLabelValues(namespace.FromContext(ctx), action.ID(), trigger.RuleID).Inc()
+	e.stats.update(action.ID(), func(s *ActionStats) { s.Shadowed++ })
+
+	return NewActionResult(action.ID()).
+		WithMetadata("shadow", true).
+		WithMetadata("would", description)
+}
+
+// executeWithRetry executes an action, retrying retryable failures according to the
+// action's RetryConfig. It returns the number of attempts made and the last error.
+// When all attempts fail, the error wraps both ErrMaxRetriesExceeded and the last failure.
+func (e *Executor) executeWithRetry(ctx context.Context, action Action, trigger *rule.Trigger, playerCtx *signal.PlayerContext) (int, error) {
+	ctx, span := tracer.Start(ctx, "action.execute", trace.WithAttributes(
+		attribute.String("action.id", action.ID()),
+		attribute.String("rule.id", trigger.RuleID),
+		attribute.String("user.id", trigger.UserID),
+	))
+	defer span.End()
+
+	start := time.Now()
+	attempts, err := e.runAttempts(ctx, action, trigger, playerCtx)
+	span.SetAttributes(attribute.Int("action.attempts", attempts))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	metrics.ActionExecutionDurationSeconds.WithLabelValues(action.ID()).Observe(time.Since(start).Seconds())
+	ns := namespace.FromContext(ctx)
+	metrics.ActionExecutionsTotal.WithLabelValues(ns, action.ID()).Inc()
+	if err != nil {
+		metrics.ActionFailuresTotal.WithLabelValues(ns, action.ID()).Inc()
+	}
+	e.stats.recordExecution(action.ID(), err)
+	return attempts, err
+}
+
+// runAttempts executes an action until it succeeds, fails permanently or runs out of attempts.
+func (e *Executor) runAttempts(ctx context.Context, action Action, trigger *rule.Trigger, playerCtx *signal.PlayerContext) (int, error) {
+	retry := action.Config().Retry
+	maxAttempts := retry.attempts()
+
+	for attempt := 1; ; attempt++ {
+		err := action.Execute(ctx, trigger, playerCtx)
+		if err == nil {
+			return attempt, nil
+		}
+
+		if maxAttempts == 1 || !IsRetryable(err) {
+			return attempt, err
+		}
+
+		if attempt >= maxAttempts {
+			metrics.ActionRetriesExhaustedTotal.WithLabelValues(action.ID()).Inc()
+			return attempt, fmt.Errorf("%w after %d attempts: %w", ErrMaxRetriesExceeded, attempt, err)
+		}
+
+		delay := retry.delayAfter(attempt)
+		logrus.Warnf("action %s attempt %d/%d failed (user: %s), retrying in %v: %v",
+			action.ID(), attempt, maxAttempts, trigger.UserID, delay, err)
+
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return attempt, fmt.Errorf("retry of action %s aborted (%v): %w", action.ID(), sleepErr, err)
+		}
+
+		metrics.ActionRetriesTotal.WithLabelValues(action.ID()).Inc()
+	}
+}
+
 // rollbackActions rolls back actions in reverse order.
 func (e *Executor) rollbackActions(ctx context.Context, actions []Action, trigger *rule.Trigger, playerCtx *signal.PlayerContext) {
 	logrus.Warnf("rolling back %d actions", len(actions))
@@ -91,6 +291,8 @@ func (e *Executor) rollbackActions(ctx context.Context, actions []Action, trigge
 	for i := len(actions) - 1; i >= 0; i-- {
 		action := actions[i]
 		logrus.Infof("rolling back action %s", action.ID())
+		metrics.ActionRollbacksTotal.WithLabelValues(namespace.FromContext(ctx), action.ID()).Inc()
+		e.stats.update(action.ID(), func(s *ActionStats) { s.Rollbacks++ })
 
 		err := action.Rollback(ctx, trigger, playerCtx)
 		if err != nil {
@@ -2,11 +2,16 @@ package action
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 // testAction is a simple action for testing
@@ -277,6 +282,361 @@ func TestExecutor_ExecuteMultiple_NoRollback(t *testing.T) {
 	}
 }
 
+func TestExecutor_Execute_RetriesUntilSuccess(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	calls := 0
+	action := &testAction{
+		id:   "flaky_action",
+		name: "Flaky Action",
+		config: ActionConfig{
+			ID:      "flaky_action",
+			Enabled: true,
+			Retry:   &RetryConfig{MaxAttempts: 3, Delay: time.Millisecond, Backoff: BackoffExponential},
+		},
+		executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			calls++
+			if calls < 3 {
+				return Retryable(&testError{msg: "temporary failure"})
+			}
+			return nil
+		},
+	}
+	registry.Register(action)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	result, err := executor.Execute(context.Background(), "flaky_action", trigger, &signal.PlayerContext{UserID: "test-user"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+
+	if result.Metadata["attempts"] != 3 {
+		t.Errorf("Expected attempts metadata 3, got %v", result.Metadata["attempts"])
+	}
+}
+
+func TestExecutor_Execute_RetriesExhausted(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	calls := 0
+	lastErr := &retryableTestError{retryable: true}
+	action := &testAction{
+		id:   "failing_action",
+		name: "Failing Action",
+		config: ActionConfig{
+			ID:      "failing_action",
+			Enabled: true,
+			Retry:   &RetryConfig{MaxAttempts: 2, Delay: time.Millisecond},
+		},
+		executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			calls++
+			return lastErr
+		},
+	}
+	registry.Register(action)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	_, err := executor.Execute(context.Background(), "failing_action", trigger, &signal.PlayerContext{UserID: "test-user"})
+
+	if !errors.Is(err, ErrMaxRetriesExceeded) {
+		t.Errorf("Expected ErrMaxRetriesExceeded, got %v", err)
+	}
+
+	if !errors.Is(err, lastErr) {
+		t.Errorf("Expected error to wrap the last failure, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestExecutor_Execute_PermanentErrorNotRetried(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"permanent wrapper", Permanent(errors.New("bad request"))},
+		{"missing player context", ErrMissingPlayerContext},
+		{"churn state conflict", fmt.Errorf("save failed: %w", service.ErrChurnStateConflict)},
+		{"non-retryable error", &retryableTestError{retryable: false}},
+		{"unclassified error", errors.New("timeout after the item was granted")},
+		{"retryable churn state conflict", Retryable(service.ErrChurnStateConflict)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			executor := NewExecutor(registry)
+
+			calls := 0
+			action := &testAction{
+				id:   "action",
+				name: "Action",
+				config: ActionConfig{
+					ID:      "action",
+					Enabled: true,
+					Retry:   &RetryConfig{MaxAttempts: 5, Delay: time.Millisecond},
+				},
+				executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+					calls++
+					return tt.err
+				},
+			}
+			registry.Register(action)
+
+			trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+			_, err := executor.Execute(context.Background(), "action", trigger, &signal.PlayerContext{UserID: "test-user"})
+
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected %v, got %v", tt.err, err)
+			}
+
+			if errors.Is(err, ErrMaxRetriesExceeded) {
+				t.Error("Expected permanent error not to be reported as retries exceeded")
+			}
+
+			if calls != 1 {
+				t.Errorf("Expected 1 attempt, got %d", calls)
+			}
+		})
+	}
+}
+
+func TestExecutor_Execute_RetryStopsOnContextCancel(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	action := &testAction{
+		id:   "slow_retry_action",
+		name: "Slow Retry Action",
+		config: ActionConfig{
+			ID:      "slow_retry_action",
+			Enabled: true,
+			Retry:   &RetryConfig{MaxAttempts: 3, Delay: time.Hour},
+		},
+		executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			calls++
+			cancel()
+			return Retryable(&testError{msg: "temporary failure"})
+		},
+	}
+	registry.Register(action)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	_, err := executor.Execute(ctx, "slow_retry_action", trigger, &signal.PlayerContext{UserID: "test-user"})
+	if err == nil {
+		t.Fatal("Expected error after context cancellation")
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestExecutor_ExecuteMultiple_RetriesBeforeRollback(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	action1 := &testAction{
+		id:     "action1",
+		name:   "Action 1",
+		config: ActionConfig{ID: "action1", Enabled: true},
+	}
+
+	calls := 0
+	action2 := &testAction{
+		id:   "action2",
+		name: "Action 2",
+		config: ActionConfig{
+			ID:      "action2",
+			Enabled: true,
+			Retry:   &RetryConfig{MaxAttempts: 2, Delay: time.Millisecond, Backoff: BackoffLinear},
+		},
+		executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			calls++
+			if calls == 1 {
+				return Retryable(&testError{msg: "temporary failure"})
+			}
+			return nil
+		},
+	}
+
+	registry.Register(action1)
+	registry.Register(action2)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	results, err := executor.ExecuteMultiple(context.Background(), []string{"action1", "action2"}, trigger, &signal.PlayerContext{UserID: "test-user"}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(results) != 2 || !results[1].Success {
+		t.Errorf("Expected both actions to succeed, got %+v", results)
+	}
+
+	if action1.rollbackCalled {
+		t.Error("Expected no rollback when the retry succeeds")
+	}
+}
+
+func TestRetryConfig_DelayAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *RetryConfig
+		attempt int
+		want    time.Duration
+	}{
+		{"nil config", nil, 1, 0},
+		{"constant", &RetryConfig{Delay: time.Second}, 3, time.Second},
+		{"linear", &RetryConfig{Delay: time.Second, Backoff: BackoffLinear}, 3, 3 * time.Second},
+		{"exponential", &RetryConfig{Delay: time.Second, Backoff: BackoffExponential}, 4, 8 * time.Second},
+		{"exponential capped", &RetryConfig{Delay: time.Second, Backoff: BackoffExponential}, 20, maxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.delayAfter(tt.attempt); got != tt.want {
+				t.Errorf("delayAfter(%d) = %v, want %v", tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecutor_ExecuteMultiple_ShadowAction(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	live := &testAction{id: "live", config: ActionConfig{ID: "live", Enabled: true}}
+	shadow := &testAction{id: "shadow", config: ActionConfig{ID: "shadow", Enabled: true, Mode: rule.ModeShadow}}
+	registry.Register(live)
+	registry.Register(shadow)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	playerCtx := &signal.PlayerContext{UserID: "test-user", State: &service.ChurnState{}}
+
+	results, err := executor.ExecuteMultiple(context.Background(), []string{"live", "shadow"}, trigger, playerCtx, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !live.executeCalled {
+		t.Error("Expected live action to be executed")
+	}
+	if shadow.executeCalled {
+		t.Error("Expected shadow action not to be executed")
+	}
+
+	if len(results) != 2 || results[1].Metadata["shadow"] != true {
+		t.Fatalf("Expected second result to be a shadow result, got %+v", results)
+	}
+	if results[1].Metadata["would"] != "execute action shadow" {
+		t.Errorf("Expected default shadow description, got %v", results[1].Metadata["would"])
+	}
+}
+
+func TestExecutor_Execute_ShadowTrigger(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	action := &testAction{id: "live", config: ActionConfig{ID: "live", Enabled: true}}
+	registry.Register(action)
+
+	trigger := rule.NewTrigger("shadow_rule", "test-user", "test reason", 10)
+	trigger.Shadow = true
+
+	result, err := executor.Execute(context.Background(), "live", trigger, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if action.executeCalled {
+		t.Error("Expected action of a shadow trigger not to be executed")
+	}
+	if result.Metadata["shadow"] != true {
+		t.Errorf("Expected shadow result, got %+v", result.Metadata)
+	}
+}
+
+func TestExecutor_Stats(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	first := &testAction{id: "first", config: ActionConfig{ID: "first", Enabled: true}}
+	failing := &testAction{
+		id:     "failing",
+		config: ActionConfig{ID: "failing", Enabled: true},
+		executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			return errors.New("boom")
+		},
+	}
+	registry.Register(first)
+	registry.Register(failing)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	executor.ExecuteMultiple(context.Background(), []string{"first", "failing"}, trigger, nil, true)
+
+	stats := executor.Stats()
+	if got, want := stats.ByAction["first"], (ActionStats{Executions: 1, Successes: 1, Rollbacks: 1}); got != want {
+		t.Errorf("expected first stats %+v, got %+v", want, got)
+	}
+	if got, want := stats.ByAction["failing"], (ActionStats{Executions: 1, Failures: 1}); got != want {
+		t.Errorf("expected failing stats %+v, got %+v", want, got)
+	}
+	if stats.TotalActionsExecuted != 2 || stats.SuccessfulActions != 1 || stats.FailedActions != 1 {
+		t.Errorf("unexpected totals: %+v", stats)
+	}
+}
+
+func TestExecutor_Metrics(t *testing.T) {
+	registry := NewRegistry()
+	executor := NewExecutor(registry)
+
+	succeeding := &testAction{id: "metrics_ok", config: ActionConfig{ID: "metrics_ok", Enabled: true}}
+	failing := &testAction{
+		id:     "metrics_failing",
+		config: ActionConfig{ID: "metrics_failing", Enabled: true},
+		executeFunc: func(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+			return errors.New("boom")
+		},
+	}
+	registry.Register(succeeding)
+	registry.Register(failing)
+
+	trigger := rule.NewTrigger("test_rule", "test-user", "test reason", 10)
+	executor.ExecuteMultiple(context.Background(), []string{"metrics_ok", "metrics_failing"}, trigger, nil, true)
+
+	checks := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"ok executions", testutil.ToFloat64(metrics.ActionExecutionsTotal.WithLabelValues("", "metrics_ok")), 1},
+		{"ok failures", testutil.ToFloat64(metrics.ActionFailuresTotal.WithLabelValues("", "metrics_ok")), 0},
+		{"ok rollbacks", testutil.ToFloat64(metrics.ActionRollbacksTotal.WithLabelValues("", "metrics_ok")), 1},
+		{"failing executions", testutil.ToFloat64(metrics.ActionExecutionsTotal.WithLabelValues("", "metrics_failing")), 1},
+		{"failing failures", testutil.ToFloat64(metrics.ActionFailuresTotal.WithLabelValues("", "metrics_failing")), 1},
+	}
+	for _, check := range checks {
+		if check.got != check.want {
+			t.Errorf("%s: expected %v, got %v", check.name, check.want, check.got)
+		}
+	}
+
+	if got := testutil.CollectAndCount(metrics.ActionExecutionDurationSeconds); got < 2 {
+		t.Errorf("expected duration observations for both actions, got %d series", got)
+	}
+}
+
 type testError struct {
 	msg string
 }
@@ -284,3 +644,15 @@ type testError struct {
 func (e *testError) Error() string {
 	return e.msg
 }
+
+type retryableTestError struct {
+	retryable bool
+}
+
+func (e *retryableTestError) Error() string {
+	return "retryable test error"
+}
+
+func (e *retryableTestError) Retryable() bool {
+	return e.retryable
+}
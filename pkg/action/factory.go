@@ -3,6 +3,7 @@ package action
 import (
 	"fmt"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/sirupsen/logrus"
 )
 
@@ -12,13 +13,34 @@ type ActionFactory func(config ActionConfig) (Action, error)
 // factories stores registered action factories by type
 var factories = make(map[string]ActionFactory)
 
+// schemas stores the parameter schemas of registered action types
+var schemas = make(map[string]param.Schema)
+
 // RegisterActionType registers a factory function for an action type.
 // This allows external packages to register their action types without creating import cycles.
+// Its parameters are not validated; use RegisterActionTypeWithSchema to declare them.
 func RegisterActionType(actionType string, factory ActionFactory) {
+	RegisterActionTypeWithSchema(actionType, nil, factory)
+}
+
+// RegisterActionTypeWithSchema registers a factory function for an action type along with
+// the schema of the parameters the type reads. Parameters are validated against the schema
+// before the factory is called. A nil schema skips validation.
+func RegisterActionTypeWithSchema(actionType string, schema param.Schema, factory ActionFactory) {
 	factories[actionType] = factory
+	schemas[actionType] = schema
 	logrus.Debugf("registered action type: %s", actionType)
 }
 
+// Schemas returns the parameter schemas of the registered action types, by type.
+func Schemas() map[string]param.Schema {
+	result := make(map[string]param.Schema, len(schemas))
+	for actionType, schema := range schemas {
+		result[actionType] = schema
+	}
+	return result
+}
+
 // CreateAction creates an action instance based on the configuration.
 // Returns an error if the action type is unknown.
 func CreateAction(config ActionConfig) (Action, error) {
@@ -34,6 +56,10 @@ func CreateAction(config ActionConfig) (Action, error) {
 		return nil, fmt.Errorf("unknown action type: %s", config.Type)
 	}
 
+	if err := schemas[config.Type].Validate(config.Parameters); err != nil {
+		return nil, fmt.Errorf("invalid parameters for action %s: %w", config.ID, err)
+	}
+
 	return factory(config)
 }
 
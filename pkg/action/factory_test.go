@@ -25,6 +25,10 @@ func (m *mockStateStore) UpdateChurnState(ctx context.Context, userID string, st
 	return nil
 }
 
+func (m *mockStateStore) DeleteChurnState(ctx context.Context, userID string) error {
+	return nil
+}
+
 // mockItemGranter for testing
 type mockItemGranter struct{}
 
@@ -75,9 +79,10 @@ func TestCreateAction_GrantItem(t *testing.T) {
 	actionBuiltin.RegisterActions(deps)
 
 	config := action.ActionConfig{
-		ID:      "test_grant",
-		Type:    actionBuiltin.GrantItemActionID,
-		Enabled: true,
+		ID:         "test_grant",
+		Type:       actionBuiltin.GrantItemActionID,
+		Enabled:    true,
+		Parameters: map[string]interface{}{"item_id": "TEST_ITEM"},
 	}
 
 	act, err := action.CreateAction(config)
@@ -148,9 +153,10 @@ func TestCreateActions_Multiple(t *testing.T) {
 			Enabled: true,
 		},
 		{
-			ID:      "grant1",
-			Type:    actionBuiltin.GrantItemActionID,
-			Enabled: true,
+			ID:         "grant1",
+			Type:       actionBuiltin.GrantItemActionID,
+			Enabled:    true,
+			Parameters: map[string]interface{}{"item_id": "TEST_ITEM"},
 		},
 	}
 
@@ -222,9 +228,10 @@ func TestRegisterActions(t *testing.T) {
 			Enabled: true,
 		},
 		{
-			ID:      "grant1",
-			Type:    actionBuiltin.GrantItemActionID,
-			Enabled: true,
+			ID:         "grant1",
+			Type:       actionBuiltin.GrantItemActionID,
+			Enabled:    true,
+			Parameters: map[string]interface{}{"item_id": "TEST_ITEM"},
 		},
 	}
 
@@ -258,9 +265,10 @@ func TestRegisterActions_DuplicateID(t *testing.T) {
 			Enabled: true,
 		},
 		{
-			ID:      "same_id", // Duplicate ID
-			Type:    actionBuiltin.GrantItemActionID,
-			Enabled: true,
+			ID:         "same_id", // Duplicate ID
+			Type:       actionBuiltin.GrantItemActionID,
+			Enabled:    true,
+			Parameters: map[string]interface{}{"item_id": "TEST_ITEM"},
 		},
 	}
 
@@ -269,3 +277,30 @@ func TestRegisterActions_DuplicateID(t *testing.T) {
 		t.Error("Expected error for duplicate action ID")
 	}
 }
+
+func TestCreateAction_InvalidParameters(t *testing.T) {
+	actionBuiltin.RegisterActions(&actionBuiltin.Dependencies{})
+
+	config := action.ActionConfig{
+		ID:      "test_challenge",
+		Type:    actionBuiltin.ComebackChallengeActionID,
+		Enabled: true,
+		Parameters: map[string]interface{}{
+			"wins_needed":   3.0,
+			"duration_days": "7",
+			"winsneeded":    3,
+		},
+	}
+
+	_, err := action.CreateAction(config)
+	if err == nil {
+		t.Fatal("Expected error for invalid parameters")
+	}
+
+	expected := "invalid parameters for action test_challenge: wins_needed must be an integer, got number 3.0; " +
+		"duration_days must be an integer, got string \"7\"; " +
+		"winsneeded is not a known parameter (expected wins_needed, duration_days, cooldown_hours)"
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
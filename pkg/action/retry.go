@@ -0,0 +1,106 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+)
+
+const (
+	// BackoffConstant waits the configured delay between every attempt.
+	BackoffConstant = "constant"
+	// BackoffLinear waits delay * n before attempt n+1.
+	BackoffLinear = "linear"
+	// BackoffExponential waits delay * 2^(n-1) before attempt n+1.
+	BackoffExponential = "exponential"
+
+	// maxRetryDelay caps the wait between attempts so a misconfigured
+	// exponential backoff cannot block the pipeline indefinitely.
+	maxRetryDelay = time.Minute
+)
+
+// IsRetryable reports whether a failed action may succeed when executed again.
+// Only errors that say so are retried: a RetryableError that reports true, such as an
+// error wrapped with Retryable. Other errors are not, since an action that failed with an
+// unclassified error, e.g. a timeout after AGS already granted an item, may have applied
+// its side effects. Context cancellations, errors wrapped with Permanent, the
+// configuration/context sentinels of this package and churn state conflicts are never
+// retried, even if wrapped in a RetryableError.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	for _, permanent := range []error{
+		ErrPermanent,
+		ErrMissingPlayerContext,
+		ErrInvalidConfig,
+		ErrActionDisabled,
+		ErrActionNotFound,
+		service.ErrChurnStateConflict,
+	} {
+		if errors.Is(err, permanent) {
+			return false
+		}
+	}
+
+	var retryable RetryableError
+	if errors.As(err, &retryable) {
+		return retryable.Retryable()
+	}
+
+	return false
+}
+
+// attempts returns the total number of attempts allowed by the retry config.
+func (r *RetryConfig) attempts() int {
+	if r == nil || r.MaxAttempts < 1 {
+		return 1
+	}
+	return r.MaxAttempts
+}
+
+// delayAfter returns how long to wait after the given failed attempt (1-based).
+func (r *RetryConfig) delayAfter(attempt int) time.Duration {
+	if r == nil || r.Delay <= 0 {
+		return 0
+	}
+
+	delay := r.Delay
+	switch r.Backoff {
+	case BackoffLinear:
+		delay = r.Delay * time.Duration(attempt)
+	case BackoffExponential:
+		for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+			delay *= 2
+		}
+	}
+
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
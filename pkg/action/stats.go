@@ -0,0 +1,60 @@
+package action
+
+import "github.com/AccelByte/extend-churn-intervention/pkg/stats"
+
+// ExecutorStats is a snapshot of the action executor counters.
+type ExecutorStats struct {
+	TotalActionsExecuted int64                  `json:"total_actions_executed"`
+	SuccessfulActions    int64                  `json:"successful_actions"`
+	FailedActions        int64                  `json:"failed_actions"`
+	ShadowActions        int64                  `json:"shadow_actions"` // Recorded, not executed; excluded from the totals above
+	ByAction             map[string]ActionStats `json:"by_action"`
+}
+
+// ActionStats contains the action executor counters of a single action.
+// An execution includes all of its retry attempts.
+type ActionStats struct {
+	Executions int64 `json:"executions"`
+	Successes  int64 `json:"successes"`
+	Failures   int64 `json:"failures"`
+	Shadowed   int64 `json:"shadowed"`
+	Rollbacks  int64 `json:"rollbacks"`
+}
+
+// executorStats maintains the live action executor counters, by action ID.
+type executorStats struct {
+	byAction *stats.Counters[ActionStats]
+}
+
+func newExecutorStats() *executorStats {
+	return &executorStats{byAction: stats.NewCounters[ActionStats]()}
+}
+
+// update applies fn to the counters of actionID.
+func (s *executorStats) update(actionID string, fn func(stats *ActionStats)) {
+	s.byAction.Update(actionID, fn)
+}
+
+// recordExecution counts a completed execution of actionID.
+func (s *executorStats) recordExecution(actionID string, err error) {
+	s.update(actionID, func(stats *ActionStats) {
+		stats.Executions++
+		if err != nil {
+			stats.Failures++
+		} else {
+			stats.Successes++
+		}
+	})
+}
+
+// snapshot returns a copy of the counters with totals across actions.
+func (s *executorStats) snapshot() ExecutorStats {
+	snapshot := ExecutorStats{ByAction: s.byAction.Snapshot()}
+	for _, actionStats := range snapshot.ByAction {
+		snapshot.TotalActionsExecuted += actionStats.Executions
+		snapshot.SuccessfulActions += actionStats.Successes
+		snapshot.FailedActions += actionStats.Failures
+		snapshot.ShadowActions += actionStats.Shadowed
+	}
+	return snapshot
+}
@@ -0,0 +1,52 @@
+// Package audit records why the pipeline did or did not intervene for a player.
+// One Record is written per processed signal to a Sink, so decisions can be
+// explained long after the logs are gone.
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Action statuses recorded for each action run for a trigger.
+const (
+	ActionStatusSucceeded = "succeeded" // Action executed successfully
+	ActionStatusFailed    = "failed"    // Action failed after all retries
+	ActionStatusQueued    = "queued"    // Async action dispatched to the worker pool
+	ActionStatusShadow    = "shadow"    // Shadow action recorded what it would do
+)
+
+// Record is the audit record of one processed signal.
+type Record struct {
+	Timestamp  time.Time        `json:"timestamp"`
+	EventType  string           `json:"event_type"`
+	EventID    string           `json:"event_id,omitempty"`
+	Namespace  string           `json:"namespace,omitempty"`
+	UserID     string           `json:"user_id"`
+	SignalType string           `json:"signal_type"`
+	Rules      []RuleDecision   `json:"rules"`
+	Actions    []ActionDecision `json:"actions,omitempty"`
+}
+
+// RuleDecision records the outcome of evaluating one rule (see rule.Decision).
+type RuleDecision struct {
+	RuleID  string `json:"rule_id"`
+	Outcome string `json:"outcome"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// ActionDecision records the result of one action run for a trigger.
+type ActionDecision struct {
+	RuleID   string `json:"rule_id"`
+	ActionID string `json:"action_id"`
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Would    string `json:"would,omitempty"` // What a shadow action would have done
+}
+
+// Sink stores audit records.
+type Sink interface {
+	// Write stores a record.
+	Write(ctx context.Context, record *Record) error
+}
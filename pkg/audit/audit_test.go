@@ -0,0 +1,183 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestRecord(userID, eventID string) *Record {
+	return &Record{
+		Timestamp:  time.Now().UTC(),
+		EventType:  "stat_item_updated",
+		EventID:    eventID,
+		UserID:     userID,
+		SignalType: "rage_quit",
+		Rules: []RuleDecision{
+			{RuleID: "rage_quit_rule", Outcome: "triggered", Reason: "3 rage quits"},
+		},
+		Actions: []ActionDecision{
+			{RuleID: "rage_quit_rule", ActionID: "grant_item", Status: ActionStatusSucceeded, Attempts: 1},
+		},
+	}
+}
+
+// readStream returns the records of a player's audit stream, oldest first.
+func readStream(t *testing.T, client *redis.Client, key string) []*Record {
+	t.Helper()
+
+	entries, err := client.XRange(context.Background(), key, "-", "+").Result()
+	if err != nil {
+		t.Fatalf("XRange() error = %v", err)
+	}
+
+	records := make([]*Record, 0, len(entries))
+	for _, entry := range entries {
+		var record Record
+		if err := json.Unmarshal([]byte(entry.Values[redisStreamSinkRecordField].(string)), &record); err != nil {
+			t.Fatalf("failed to unmarshal audit entry %s: %v", entry.ID, err)
+		}
+		records = append(records, &record)
+	}
+	return records
+}
+
+func TestRedisStreamSink_Write(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	sink := NewRedisStreamSink(client, RedisStreamSinkConfig{Retention: time.Hour})
+	ctx := namespace.NewContext(context.Background(), "game-a", "game-a")
+
+	for _, record := range []*Record{
+		newTestRecord("user-1", "event-1"),
+		newTestRecord("user-2", "event-2"),
+		newTestRecord("user-1", "event-3"),
+	} {
+		if err := sink.Write(ctx, record); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	records := readStream(t, client, redisStreamSinkKeyPrefix+"user-1")
+	if len(records) != 2 || records[0].EventID != "event-1" || records[1].EventID != "event-3" {
+		t.Fatalf("expected user-1 records event-1 and event-3, got %+v", records)
+	}
+	if records[0].Actions[0].ActionID != "grant_item" {
+		t.Errorf("expected action decision to round-trip, got %+v", records[0].Actions)
+	}
+
+	if ttl := mr.TTL(redisStreamSinkKeyPrefix + "user-1"); ttl != time.Hour {
+		t.Errorf("expected stream to expire after the retention, got TTL %v", ttl)
+	}
+
+	// Records of other namespaces go to their own streams
+	other := namespace.NewContext(context.Background(), "game-b", "game-a")
+	if err := sink.Write(other, newTestRecord("user-1", "event-4")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if records := readStream(t, client, redisStreamSinkKeyPrefix+"game-b:user-1"); len(records) != 1 || records[0].EventID != "event-4" {
+		t.Errorf("expected the game-b record in its own stream, got %+v", records)
+	}
+
+	if err := sink.Write(context.Background(), newTestRecord("user-1", "event-5")); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace without namespace, got %v", err)
+	}
+}
+
+func TestRedisStreamSink_Records(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	sink := NewRedisStreamSink(client, RedisStreamSinkConfig{Retention: time.Hour})
+	ctx := namespace.NewContext(context.Background(), "game-a", "game-a")
+	other := namespace.NewContext(context.Background(), "game-b", "game-a")
+
+	for _, record := range []*Record{
+		newTestRecord("user-1", "event-1"),
+		newTestRecord("user-2", "event-2"),
+		newTestRecord("user-1", "event-3"),
+	} {
+		if err := sink.Write(ctx, record); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := sink.Write(other, newTestRecord("user-1", "event-4")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	records, err := sink.Records(ctx, "user-1", time.Time{})
+	if err != nil {
+		t.Fatalf("Records() error = %v", err)
+	}
+	if len(records) != 2 || records[0].EventID != "event-1" || records[1].EventID != "event-3" {
+		t.Fatalf("expected user-1 records event-1 and event-3, got %+v", records)
+	}
+	if records[0].Rules[0].RuleID != "rage_quit_rule" {
+		t.Errorf("expected rule decision to round-trip, got %+v", records[0].Rules)
+	}
+
+	if records, err := sink.Records(other, "user-1", time.Time{}); err != nil || len(records) != 1 || records[0].EventID != "event-4" {
+		t.Errorf("expected only the game-b record, got %+v (err %v)", records, err)
+	}
+
+	if records, err := sink.Records(ctx, "user-1", time.Now().Add(time.Minute)); err != nil || len(records) != 0 {
+		t.Errorf("expected no records written after since, got %+v (err %v)", records, err)
+	}
+
+	if records, err := sink.Records(ctx, "user-3", time.Time{}); err != nil || len(records) != 0 {
+		t.Errorf("expected no records for an unknown player, got %+v (err %v)", records, err)
+	}
+
+	if _, err := sink.Records(context.Background(), "user-1", time.Time{}); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace without namespace, got %v", err)
+	}
+}
+
+func TestFileSink_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	for _, eventID := range []string{"event-1", "event-2"} {
+		if err := sink.Write(context.Background(), newTestRecord("user-1", eventID)); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open audit log: %v", err)
+	}
+	defer file.Close()
+
+	var eventIDs []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid audit line %q: %v", scanner.Text(), err)
+		}
+		eventIDs = append(eventIDs, record.EventID)
+	}
+
+	if len(eventIDs) != 2 || eventIDs[0] != "event-1" || eventIDs[1] != "event-2" {
+		t.Errorf("expected records event-1 and event-2, got %v", eventIDs)
+	}
+}
@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// redisStreamSinkDefaultRetention is the default retention for audit records (30 days)
+	redisStreamSinkDefaultRetention = 30 * 24 * time.Hour
+	// redisStreamSinkKeyPrefix is the prefix for all per-player audit streams
+	redisStreamSinkKeyPrefix = "churn_intervention:audit:"
+	// redisStreamSinkRecordField is the stream entry field holding the JSON record
+	redisStreamSinkRecordField = "record"
+)
+
+// RedisStreamSink implements Sink using one Redis stream per player.
+// Entries older than the retention are trimmed on write, and the stream of a
+// player without new records expires after the retention.
+type RedisStreamSink struct {
+	client *redis.Client
+	cfg    RedisStreamSinkConfig
+}
+
+// RedisStreamSinkConfig configures a RedisStreamSink.
+type RedisStreamSinkConfig struct {
+	// Retention is how long records are kept.
+	// Defaults to 30 days when zero.
+	Retention time.Duration
+}
+
+// NewRedisStreamSink creates a new Redis stream audit sink.
+func NewRedisStreamSink(client *redis.Client, cfg RedisStreamSinkConfig) *RedisStreamSink {
+	if cfg.Retention <= 0 {
+		cfg.Retention = redisStreamSinkDefaultRetention
+	}
+
+	return &RedisStreamSink{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+// makeRedisStreamSinkKey creates the Redis key of a player's audit stream in the namespace of ctx
+func makeRedisStreamSinkKey(ctx context.Context, userID string) (string, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return redisStreamSinkKeyPrefix + key, nil
+}
+
+// Write appends the record to the player's stream and trims expired records.
+func (r *RedisStreamSink) Write(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+
+	key, err := makeRedisStreamSinkKey(ctx, record.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	minID := strconv.FormatInt(time.Now().Add(-r.cfg.Retention).UnixMilli(), 10)
+
+	pipe := r.client.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MinID:  minID,
+		Approx: true,
+		Values: map[string]interface{}{redisStreamSinkRecordField: data},
+	})
+	pipe.Expire(ctx, key, r.cfg.Retention)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+
+	return nil
+}
+
+// Records returns the player's audit records in the namespace of ctx, oldest first.
+// Only records written at or after since are returned; a zero since returns all of them.
+func (r *RedisStreamSink) Records(ctx context.Context, userID string, since time.Time) ([]*Record, error) {
+	key, err := makeRedisStreamSinkKey(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit records: %w", err)
+	}
+
+	start := "-"
+	if !since.IsZero() {
+		start = strconv.FormatInt(since.UnixMilli(), 10)
+	}
+
+	entries, err := r.client.XRange(ctx, key, start, "+").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit records: %w", err)
+	}
+
+	records := make([]*Record, 0, len(entries))
+	for _, entry := range entries {
+		data, ok := entry.Values[redisStreamSinkRecordField].(string)
+		if !ok {
+			return nil, fmt.Errorf("audit entry %s has no record", entry.ID)
+		}
+		var record Record
+		if err := json.Unmarshal([]byte(data), &record); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit entry %s: %w", entry.ID, err)
+		}
+		records = append(records, &record)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,36 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVirtual(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	var c Clock = NewVirtual(start)
+
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("expected virtual start %v, got %v", start, got)
+	}
+
+	c.(*Virtual).Advance(36 * time.Hour)
+	if got := c.Now(); !got.Equal(start.Add(36 * time.Hour)) {
+		t.Errorf("expected advanced virtual time, got %v", got)
+	}
+
+	c.(*Virtual).Set(start)
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("expected virtual time set back to %v, got %v", start, got)
+	}
+}
+
+func TestOrReal(t *testing.T) {
+	if got := OrReal(nil).Now(); time.Since(got) > time.Minute {
+		t.Errorf("expected wall clock for nil, got %v", got)
+	}
+
+	virtual := NewVirtual(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+	if OrReal(virtual) != virtual {
+		t.Error("expected the given clock to be kept")
+	}
+}
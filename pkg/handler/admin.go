@@ -0,0 +1,382 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/common"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	pb_admin "github.com/AccelByte/extend-churn-intervention/pkg/pb/churn-intervention/admin/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Intervention outcomes support staff may set through the admin API
+	InterventionOutcomeCompleted = "completed"
+	InterventionOutcomeFailed    = "failed"
+
+	// maxAdminStateAttempts bounds how often an admin change is retried after
+	// losing an optimistic concurrency race with the pipeline.
+	maxAdminStateAttempts = 3
+)
+
+// CooldownClearer clears per-player rule cooldowns. It is implemented by pipeline.Manager.
+type CooldownClearer interface {
+	ClearUserCooldowns(ctx context.Context, userID string) ([]string, error)
+}
+
+// PipelineReloader reloads the pipeline config of a namespace from its file.
+// It is implemented by bootstrap.PipelineReloader.
+type PipelineReloader interface {
+	// Namespace returns the namespace of the config, "" for the default config.
+	Namespace() string
+	// Version returns the version of the running config.
+	Version() string
+	Reload() error
+}
+
+// Admin serves the admin API for inspecting and managing a player's churn state.
+// Callers are authenticated by the gRPC server (see server.GRPCServer.EnableAdmin).
+type Admin struct {
+	pb_admin.UnimplementedChurnAdminServiceServer
+
+	stateStore      service.StateStore
+	sessionTracker  service.LoginSessionTracker
+	cooldownClearer CooldownClearer
+	namespace       string // default namespace of the deployment, see namespace.NewContext
+	reloaders       []PipelineReloader
+}
+
+// NewAdmin creates a new admin API handler
+func NewAdmin(stateStore service.StateStore, sessionTracker service.LoginSessionTracker, cooldownClearer CooldownClearer, namespace string) *Admin {
+	return &Admin{
+		stateStore:      stateStore,
+		sessionTracker:  sessionTracker,
+		cooldownClearer: cooldownClearer,
+		namespace:       namespace,
+	}
+}
+
+// SetPipelineReloaders sets the reloaders of the default and namespace pipeline configs,
+// which ReloadPipelineConfig triggers. Without them, reloads are not available.
+func (s *Admin) SetPipelineReloaders(reloaders ...PipelineReloader) {
+	s.reloaders = reloaders
+}
+
+// GetPlayerState returns a player's churn state and login session tracking data
+func (s *Admin) GetPlayerState(
+	ctx context.Context,
+	req *pb_admin.GetPlayerStateRequest,
+) (*pb_admin.GetPlayerStateResponse, error) {
+	scope := common.GetScopeFromContext(ctx, "Admin.GetPlayerState")
+	defer scope.Finish()
+
+	ctx, err := s.validateRequest(ctx, req.GetNamespace(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	churnState, err := s.stateStore.GetChurnState(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get churn state: %v", err)
+	}
+
+	sessionData, err := s.sessionTracker.GetSessionData(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session tracking data: %v", err)
+	}
+
+	return &pb_admin.GetPlayerStateResponse{
+		UserId:          req.GetUserId(),
+		ChurnState:      toPBChurnState(churnState),
+		SessionTracking: toPBSessionTrackingData(sessionData),
+	}, nil
+}
+
+// ClearCooldowns ends a player's intervention cooldown and per-player rule cooldowns
+func (s *Admin) ClearCooldowns(
+	ctx context.Context,
+	req *pb_admin.ClearCooldownsRequest,
+) (*pb_admin.ClearCooldownsResponse, error) {
+	scope := common.GetScopeFromContext(ctx, "Admin.ClearCooldowns")
+	defer scope.Finish()
+
+	ctx, err := s.validateRequest(ctx, req.GetNamespace(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	churnState, err := s.updateChurnState(ctx, req.GetUserId(), func(state *service.ChurnState) error {
+		state.Cooldown.CooldownUntil = time.Time{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clearedRuleIDs, err := s.cooldownClearer.ClearUserCooldowns(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear rule cooldowns: %v", err)
+	}
+
+	logrus.Infof("admin cleared cooldowns for user %s (rules: %v)", req.GetUserId(), clearedRuleIDs)
+	return &pb_admin.ClearCooldownsResponse{
+		ClearedRuleIds: clearedRuleIDs,
+		ChurnState:     toPBChurnState(churnState),
+	}, nil
+}
+
+// UpdateInterventionOutcome marks one of a player's interventions completed or failed
+func (s *Admin) UpdateInterventionOutcome(
+	ctx context.Context,
+	req *pb_admin.UpdateInterventionOutcomeRequest,
+) (*pb_admin.UpdateInterventionOutcomeResponse, error) {
+	scope := common.GetScopeFromContext(ctx, "Admin.UpdateInterventionOutcome")
+	defer scope.Finish()
+
+	ctx, err := s.validateRequest(ctx, req.GetNamespace(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GetOutcome() {
+	case InterventionOutcomeCompleted, InterventionOutcomeFailed:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "outcome must be %q or %q, got %q",
+			InterventionOutcomeCompleted, InterventionOutcomeFailed, req.GetOutcome())
+	}
+
+	churnState, err := s.updateChurnState(ctx, req.GetUserId(), func(state *service.ChurnState) error {
+		if !state.UpdateInterventionOutcome(req.GetInterventionId(), req.GetOutcome(), time.Now()) {
+			return status.Errorf(codes.NotFound, "intervention %s not found for user %s", req.GetInterventionId(), req.GetUserId())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("admin marked intervention %s of user %s as %s", req.GetInterventionId(), req.GetUserId(), req.GetOutcome())
+	intervention := churnState.GetInterventionByID(req.GetInterventionId())
+	return &pb_admin.UpdateInterventionOutcomeResponse{
+		Intervention: toPBInterventionRecord(*intervention),
+	}, nil
+}
+
+// DeleteChurnState deletes a player's churn state
+func (s *Admin) DeleteChurnState(
+	ctx context.Context,
+	req *pb_admin.DeleteChurnStateRequest,
+) (*emptypb.Empty, error) {
+	scope := common.GetScopeFromContext(ctx, "Admin.DeleteChurnState")
+	defer scope.Finish()
+
+	ctx, err := s.validateRequest(ctx, req.GetNamespace(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.stateStore.DeleteChurnState(ctx, req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete churn state: %v", err)
+	}
+
+	logrus.Infof("admin deleted churn state of user %s", req.GetUserId())
+	return &emptypb.Empty{}, nil
+}
+
+// ReloadPipelineConfig reloads the pipeline config of a namespace from its file, or the
+// default config if the namespace has no config of its own. A config that does not load
+// is rejected with FailedPrecondition and the running config is kept.
+func (s *Admin) ReloadPipelineConfig(
+	ctx context.Context,
+	req *pb_admin.ReloadPipelineConfigRequest,
+) (*pb_admin.ReloadPipelineConfigResponse, error) {
+	scope := common.GetScopeFromContext(ctx, "Admin.ReloadPipelineConfig")
+	defer scope.Finish()
+
+	if req.GetNamespace() == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	reloader := s.pipelineReloader(req.GetNamespace())
+	if reloader == nil {
+		return nil, status.Error(codes.Unimplemented, "pipeline config reload is not available")
+	}
+
+	previousVersion := reloader.Version()
+	if err := reloader.Reload(); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "pipeline config was not reloaded, running version %s: %v", previousVersion, err)
+	}
+	version := reloader.Version()
+
+	logrus.Infof("admin reloaded pipeline config of namespace %s: version %s -> %s", req.GetNamespace(), previousVersion, version)
+	return &pb_admin.ReloadPipelineConfigResponse{
+		PreviousVersion: previousVersion,
+		Version:         version,
+		Reloaded:        version != previousVersion,
+	}, nil
+}
+
+// pipelineReloader returns the reloader of the namespace's own config, or of the default
+// config if the namespace has none. Returns nil if no reloaders are set.
+func (s *Admin) pipelineReloader(ns string) PipelineReloader {
+	var defaultReloader PipelineReloader
+	for _, reloader := range s.reloaders {
+		switch reloader.Namespace() {
+		case ns:
+			return reloader
+		case "":
+			defaultReloader = reloader
+		}
+	}
+	return defaultReloader
+}
+
+// validateRequest checks that a request names a namespace and a user, and scopes ctx to
+// the namespace, so that the player's state is looked up in the namespace of the request.
+// The caller's permission in the namespace is checked by the gRPC server.
+func (s *Admin) validateRequest(ctx context.Context, ns, userID string) (context.Context, error) {
+	if ns == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	return namespace.NewContext(ctx, ns, s.namespace), nil
+}
+
+// updateChurnState applies update to a player's churn state and saves it, reloading and
+// reapplying the update if the pipeline changed the state concurrently.
+// Errors returned by update are passed through unchanged.
+func (s *Admin) updateChurnState(ctx context.Context, userID string, update func(*service.ChurnState) error) (*service.ChurnState, error) {
+	for attempt := 1; ; attempt++ {
+		churnState, err := s.stateStore.GetChurnState(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get churn state: %v", err)
+		}
+
+		if err := update(churnState); err != nil {
+			return nil, err
+		}
+
+		err = s.stateStore.UpdateChurnState(ctx, userID, churnState)
+		if err == nil {
+			return churnState, nil
+		}
+		if !errors.Is(err, service.ErrChurnStateConflict) {
+			return nil, status.Errorf(codes.Internal, "failed to update churn state: %v", err)
+		}
+		if attempt >= maxAdminStateAttempts {
+			return nil, status.Errorf(codes.Aborted, "churn state of user %s is changing, try again: %v", userID, err)
+		}
+	}
+}
+
+func toPBChurnState(state *service.ChurnState) *pb_admin.ChurnState {
+	pbState := &pb_admin.ChurnState{
+		Cooldown: &pb_admin.CooldownState{
+			LastInterventionAt: toPBTimestamp(state.Cooldown.LastInterventionAt),
+			CooldownUntil:      toPBTimestamp(state.Cooldown.CooldownUntil),
+			InterventionCounts: make(map[string]int64, len(state.Cooldown.InterventionCounts)),
+			LastSignalAt:       make(map[string]*timestamppb.Timestamp, len(state.Cooldown.LastSignalAt)),
+			OnCooldown:         state.Cooldown.IsOnCooldown(time.Now()),
+		},
+		Revision: state.Revision,
+	}
+
+	for interventionType, count := range state.Cooldown.InterventionCounts {
+		pbState.Cooldown.InterventionCounts[interventionType] = int64(count)
+	}
+	for signalType, at := range state.Cooldown.LastSignalAt {
+		pbState.Cooldown.LastSignalAt[signalType] = toPBTimestamp(at)
+	}
+
+	for _, sig := range state.SignalHistory {
+		pbState.SignalHistory = append(pbState.SignalHistory, &pb_admin.ChurnSignal{
+			Type:       sig.Type,
+			DetectedAt: toPBTimestamp(sig.DetectedAt),
+			Severity:   sig.Severity,
+			Metadata:   toPBStruct(sig.Metadata),
+		})
+	}
+	for _, intervention := range state.InterventionHistory {
+		pbState.InterventionHistory = append(pbState.InterventionHistory, toPBInterventionRecord(intervention))
+	}
+	for _, record := range state.ShadowHistory {
+		pbState.ShadowHistory = append(pbState.ShadowHistory, &pb_admin.ShadowRecord{
+			RuleId:      record.RuleID,
+			ActionId:    record.ActionID,
+			Description: record.Description,
+			RecordedAt:  toPBTimestamp(record.RecordedAt),
+		})
+	}
+
+	return pbState
+}
+
+func toPBInterventionRecord(intervention service.InterventionRecord) *pb_admin.InterventionRecord {
+	record := &pb_admin.InterventionRecord{
+		Id:          intervention.ID,
+		Type:        intervention.Type,
+		TriggeredBy: intervention.TriggeredBy,
+		TriggeredAt: toPBTimestamp(intervention.TriggeredAt),
+		Outcome:     intervention.Outcome,
+		Metadata:    toPBStruct(intervention.Metadata),
+	}
+	if intervention.ExpiresAt != nil {
+		record.ExpiresAt = toPBTimestamp(*intervention.ExpiresAt)
+	}
+	if intervention.OutcomeAt != nil {
+		record.OutcomeAt = toPBTimestamp(*intervention.OutcomeAt)
+	}
+	return record
+}
+
+func toPBSessionTrackingData(data *service.SessionTrackingData) *pb_admin.SessionTrackingData {
+	pbData := &pb_admin.SessionTrackingData{LoginCount: make(map[string]int64)}
+	if data == nil {
+		return pbData
+	}
+	for yearWeek, count := range data.LoginCount {
+		pbData.LoginCount[yearWeek] = int64(count)
+	}
+	return pbData
+}
+
+// toPBTimestamp converts t, leaving the zero time unset.
+func toPBTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// toPBStruct converts free-form metadata. Values are normalized through JSON, as stored
+// state is, so that metadata built in memory (e.g. with ints or times) converts too.
+func toPBStruct(metadata map[string]interface{}) *structpb.Struct {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		logrus.Warnf("failed to encode metadata: %v", err)
+		return nil
+	}
+
+	pbStruct := &structpb.Struct{}
+	if err := pbStruct.UnmarshalJSON(data); err != nil {
+		logrus.Warnf("failed to convert metadata: %v", err)
+		return nil
+	}
+	return pbStruct
+}
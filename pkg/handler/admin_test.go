@@ -0,0 +1,303 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	pb_admin "github.com/AccelByte/extend-churn-intervention/pkg/pb/churn-intervention/admin/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"github.com/alicebob/miniredis/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setupTestAdmin creates an admin handler backed by miniredis
+func setupTestAdmin(t *testing.T) (*Admin, service.StateStore, service.LoginSessionTracker) {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+
+	client := getRedisClient(mr)
+	stateStore := service.NewRedisChurnStateStore(client, service.RedisChurnStateStoreConfig{})
+	sessionTracker := service.NewRedisLoginSessionTrackingStore(client, service.RedisLoginSessionTrackingStoreConfig{})
+	pipelineManager := setupTestPipeline("test-namespace", mr)
+
+	return NewAdmin(stateStore, sessionTracker, pipelineManager, "test-namespace"), stateStore, sessionTracker
+}
+
+// testContext returns a context scoped to the default namespace of the test admin handler
+func testContext() context.Context {
+	return namespace.NewContext(context.Background(), "test-namespace", "test-namespace")
+}
+
+// saveTestState stores a churn state with one active intervention and an active cooldown
+func saveTestState(t *testing.T, stateStore service.StateStore, userID string) {
+	t.Helper()
+
+	ctx := testContext()
+	state, err := stateStore.GetChurnState(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	state.AddSignal("rage_quit", "high", map[string]interface{}{"rage_quit_count": 3}, time.Now())
+	state.AddIntervention("intervention-1", "comeback_challenge", "rage_quit_rule", nil, nil, time.Now())
+	state.Cooldown.CooldownUntil = time.Now().Add(48 * time.Hour)
+
+	if err := stateStore.UpdateChurnState(ctx, userID, state); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+}
+
+func TestAdmin_GetPlayerState(t *testing.T) {
+	admin, stateStore, sessionTracker := setupTestAdmin(t)
+	ctx := testContext()
+
+	saveTestState(t, stateStore, "test-user")
+	if err := sessionTracker.IncrementSessionCount(ctx, "test-user", time.Now()); err != nil {
+		t.Fatalf("failed to track session: %v", err)
+	}
+
+	resp, err := admin.GetPlayerState(ctx, &pb_admin.GetPlayerStateRequest{
+		Namespace: "test-namespace",
+		UserId:    "test-user",
+	})
+	if err != nil {
+		t.Fatalf("GetPlayerState() error = %v", err)
+	}
+
+	state := resp.GetChurnState()
+	if len(state.GetSignalHistory()) != 1 || len(state.GetInterventionHistory()) != 1 {
+		t.Fatalf("expected 1 signal and 1 intervention, got %+v", state)
+	}
+	if got := state.GetSignalHistory()[0].GetMetadata().GetFields()["rage_quit_count"].GetNumberValue(); got != 3 {
+		t.Errorf("expected signal metadata rage_quit_count=3, got %v", got)
+	}
+	if !state.GetCooldown().GetOnCooldown() {
+		t.Error("expected player to be on cooldown")
+	}
+	if state.GetRevision() != 1 {
+		t.Errorf("expected revision 1, got %d", state.GetRevision())
+	}
+	if len(resp.GetSessionTracking().GetLoginCount()) != 1 {
+		t.Errorf("expected login count for one week, got %v", resp.GetSessionTracking().GetLoginCount())
+	}
+}
+
+func TestAdmin_ValidatesRequest(t *testing.T) {
+	admin, _, _ := setupTestAdmin(t)
+	ctx := testContext()
+
+	_, err := admin.GetPlayerState(ctx, &pb_admin.GetPlayerStateRequest{UserId: "test-user"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without namespace, got %v", err)
+	}
+
+	_, err = admin.GetPlayerState(ctx, &pb_admin.GetPlayerStateRequest{Namespace: "test-namespace"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without user ID, got %v", err)
+	}
+}
+
+func TestAdmin_ClearCooldowns(t *testing.T) {
+	admin, stateStore, _ := setupTestAdmin(t)
+	ctx := testContext()
+
+	saveTestState(t, stateStore, "test-user")
+
+	resp, err := admin.ClearCooldowns(ctx, &pb_admin.ClearCooldownsRequest{
+		Namespace: "test-namespace",
+		UserId:    "test-user",
+	})
+	if err != nil {
+		t.Fatalf("ClearCooldowns() error = %v", err)
+	}
+	if resp.GetChurnState().GetCooldown().GetOnCooldown() {
+		t.Error("expected cooldown to be cleared in response")
+	}
+
+	state, err := stateStore.GetChurnState(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	if state.Cooldown.IsOnCooldown(time.Now()) {
+		t.Error("expected stored cooldown to be cleared")
+	}
+	if len(state.InterventionHistory) != 1 {
+		t.Errorf("expected intervention history to be kept, got %d records", len(state.InterventionHistory))
+	}
+}
+
+func TestAdmin_UpdateInterventionOutcome(t *testing.T) {
+	admin, stateStore, _ := setupTestAdmin(t)
+	ctx := testContext()
+
+	saveTestState(t, stateStore, "test-user")
+
+	resp, err := admin.UpdateInterventionOutcome(ctx, &pb_admin.UpdateInterventionOutcomeRequest{
+		Namespace:      "test-namespace",
+		UserId:         "test-user",
+		InterventionId: "intervention-1",
+		Outcome:        InterventionOutcomeCompleted,
+	})
+	if err != nil {
+		t.Fatalf("UpdateInterventionOutcome() error = %v", err)
+	}
+	if resp.GetIntervention().GetOutcome() != InterventionOutcomeCompleted || resp.GetIntervention().GetOutcomeAt() == nil {
+		t.Errorf("expected completed intervention with outcome time, got %+v", resp.GetIntervention())
+	}
+
+	state, err := stateStore.GetChurnState(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	if len(state.GetActiveInterventions(time.Now())) != 0 {
+		t.Errorf("expected no active interventions, got %d", len(state.GetActiveInterventions(time.Now())))
+	}
+}
+
+func TestAdmin_UpdateInterventionOutcome_Errors(t *testing.T) {
+	admin, stateStore, _ := setupTestAdmin(t)
+	ctx := testContext()
+
+	saveTestState(t, stateStore, "test-user")
+
+	_, err := admin.UpdateInterventionOutcome(ctx, &pb_admin.UpdateInterventionOutcomeRequest{
+		Namespace:      "test-namespace",
+		UserId:         "test-user",
+		InterventionId: "intervention-1",
+		Outcome:        "active",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for outcome active, got %v", err)
+	}
+
+	_, err = admin.UpdateInterventionOutcome(ctx, &pb_admin.UpdateInterventionOutcomeRequest{
+		Namespace:      "test-namespace",
+		UserId:         "test-user",
+		InterventionId: "missing",
+		Outcome:        InterventionOutcomeFailed,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for unknown intervention, got %v", err)
+	}
+}
+
+func TestAdmin_DeleteChurnState(t *testing.T) {
+	admin, stateStore, _ := setupTestAdmin(t)
+	ctx := testContext()
+
+	saveTestState(t, stateStore, "test-user")
+
+	_, err := admin.DeleteChurnState(ctx, &pb_admin.DeleteChurnStateRequest{
+		Namespace: "test-namespace",
+		UserId:    "test-user",
+	})
+	if err != nil {
+		t.Fatalf("DeleteChurnState() error = %v", err)
+	}
+
+	state, err := stateStore.GetChurnState(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	if len(state.SignalHistory) != 0 || len(state.InterventionHistory) != 0 || state.Revision != 0 {
+		t.Errorf("expected a new empty state after delete, got %+v", state)
+	}
+}
+
+func TestAdmin_OtherNamespace(t *testing.T) {
+	admin, stateStore, _ := setupTestAdmin(t)
+	ctx := testContext()
+
+	saveTestState(t, stateStore, "test-user")
+
+	// The same user ID in another namespace is another player
+	resp, err := admin.GetPlayerState(ctx, &pb_admin.GetPlayerStateRequest{Namespace: "other-namespace", UserId: "test-user"})
+	if err != nil {
+		t.Fatalf("GetPlayerState() error = %v", err)
+	}
+	if state := resp.GetChurnState(); len(state.GetSignalHistory()) != 0 || state.GetRevision() != 0 {
+		t.Errorf("expected no state in another namespace, got %+v", state)
+	}
+
+	if _, err := admin.DeleteChurnState(ctx, &pb_admin.DeleteChurnStateRequest{Namespace: "other-namespace", UserId: "test-user"}); err != nil {
+		t.Fatalf("DeleteChurnState() error = %v", err)
+	}
+	state, err := stateStore.GetChurnState(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	if len(state.SignalHistory) != 1 {
+		t.Errorf("expected the state of the default namespace to be kept, got %+v", state)
+	}
+}
+
+// fakeReloader is a PipelineReloader that switches to nextVersion, or fails with err
+type fakeReloader struct {
+	namespace   string
+	version     string
+	nextVersion string
+	err         error
+	reloads     int
+}
+
+func (r *fakeReloader) Namespace() string { return r.namespace }
+func (r *fakeReloader) Version() string   { return r.version }
+
+func (r *fakeReloader) Reload() error {
+	r.reloads++
+	if r.err != nil {
+		return r.err
+	}
+	r.version = r.nextVersion
+	return nil
+}
+
+func TestAdmin_ReloadPipelineConfig(t *testing.T) {
+	admin, _, _ := setupTestAdmin(t)
+	ctx := testContext()
+
+	_, err := admin.ReloadPipelineConfig(ctx, &pb_admin.ReloadPipelineConfigRequest{Namespace: "test-namespace"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented without reloaders, got %v", err)
+	}
+
+	defaultReloader := &fakeReloader{version: "v1", nextVersion: "v2"}
+	namespaceReloader := &fakeReloader{namespace: "other-namespace", version: "v1", nextVersion: "v1"}
+	admin.SetPipelineReloaders(defaultReloader, namespaceReloader)
+
+	resp, err := admin.ReloadPipelineConfig(ctx, &pb_admin.ReloadPipelineConfigRequest{Namespace: "test-namespace"})
+	if err != nil {
+		t.Fatalf("ReloadPipelineConfig() error = %v", err)
+	}
+	if resp.GetPreviousVersion() != "v1" || resp.GetVersion() != "v2" || !resp.GetReloaded() {
+		t.Errorf("expected reload from v1 to v2, got %+v", resp)
+	}
+
+	// A namespace with its own config reloads that config
+	resp, err = admin.ReloadPipelineConfig(ctx, &pb_admin.ReloadPipelineConfigRequest{Namespace: "other-namespace"})
+	if err != nil {
+		t.Fatalf("ReloadPipelineConfig() error = %v", err)
+	}
+	if namespaceReloader.reloads != 1 || resp.GetReloaded() {
+		t.Errorf("expected the unchanged namespace config to be reloaded once, got %d reloads and %+v", namespaceReloader.reloads, resp)
+	}
+
+	defaultReloader.err = errors.New("invalid config")
+	_, err = admin.ReloadPipelineConfig(ctx, &pb_admin.ReloadPipelineConfigRequest{Namespace: "test-namespace"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a config that does not load, got %v", err)
+	}
+
+	_, err = admin.ReloadPipelineConfig(ctx, &pb_admin.ReloadPipelineConfigRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without namespace, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/AccelByte/extend-churn-intervention/pkg/common"
 	pb_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -58,3 +59,47 @@ func (s *OAuth) OnMessage(
 	logrus.Infof("successfully processed OAuth event for user %s", userID)
 	return &emptypb.Empty{}, nil
 }
+
+// OAuthTokenRevoked listens for OAuth token revoked events
+type OAuthTokenRevoked struct {
+	pb_iam.UnimplementedOauthTokenOauthTokenRevokedServiceServer
+
+	pipelineManager *pipeline.Manager
+	namespace       string
+}
+
+// NewOAuthTokenRevoked creates a new OAuthTokenRevoked event listener
+func NewOAuthTokenRevoked(pipelineManager *pipeline.Manager, namespace string) *OAuthTokenRevoked {
+	return &OAuthTokenRevoked{
+		pipelineManager: pipelineManager,
+		namespace:       namespace,
+	}
+}
+
+// OnMessage handles oauthTokenRevoked events
+// This is called when a player logs out and their OAuth token is revoked
+func (s *OAuthTokenRevoked) OnMessage(
+	ctx context.Context,
+	msg *pb_iam.OauthTokenRevoked,
+) (*emptypb.Empty, error) {
+	scope := common.GetScopeFromContext(ctx, "OAuthTokenRevoked.OnMessage")
+	defer scope.Finish()
+
+	userID := msg.GetUserId()
+	if userID == "" {
+		logrus.Warnf("received OAuth token revoked event with empty user_id")
+		return &emptypb.Empty{}, nil
+	}
+
+	logrus.Infof("received OAuth token revoked event: userId=%s sessionId=%s namespace=%s",
+		userID, msg.GetSessionId(), msg.GetNamespace())
+
+	if err := s.pipelineManager.ProcessEvent(ctx, signalBuiltin.EventTypeOAuthTokenRevoked, msg); err != nil {
+		logrus.Errorf("pipeline processing failed for user %s: %v", userID, err)
+		return &emptypb.Empty{}, status.Errorf(codes.Internal,
+			"pipeline processing failed: %v", err)
+	}
+
+	logrus.Infof("successfully processed OAuth token revoked event for user %s", userID)
+	return &emptypb.Empty{}, nil
+}
@@ -86,3 +86,44 @@ func TestOAuth_OnMessage_WeeklyReset(t *testing.T) {
 	// Event should be processed without error
 	// Weekly reset logic would be handled by rules if configured
 }
+
+func TestOAuthTokenRevoked_OnMessage_EndsSession(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	pipelineManager := setupTestPipeline("test-namespace", mr)
+	ctx := context.Background()
+
+	if _, err := NewOAuth(pipelineManager, "test-namespace").OnMessage(ctx, &pb_iam.OauthTokenGenerated{
+		UserId:    "test-user-session",
+		Namespace: "test-namespace",
+		SessionId: "session-1",
+	}); err != nil {
+		t.Fatalf("OAuth.OnMessage() error = %v", err)
+	}
+
+	listener := NewOAuthTokenRevoked(pipelineManager, "test-namespace")
+	if _, err := listener.OnMessage(ctx, &pb_iam.OauthTokenRevoked{
+		UserId:    "test-user-session",
+		Namespace: "test-namespace",
+		SessionId: "session-1",
+	}); err != nil {
+		t.Fatalf("OnMessage() error = %v", err)
+	}
+
+	// The session was ended and its play time recorded
+	if mr.Exists("churn_intervention:session:open:test-user-session") {
+		t.Error("expected no open session after the token was revoked")
+	}
+	if !mr.Exists("churn_intervention:session:play_time:test-user-session") {
+		t.Error("expected play time to be recorded")
+	}
+
+	// Events without a user ID are dropped, not failed
+	if _, err := listener.OnMessage(ctx, &pb_iam.OauthTokenRevoked{SessionId: "session-1"}); err != nil {
+		t.Errorf("OnMessage() error = %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/common"
+	pb_social "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// StatItemCreated listens for stat item created events
+type StatItemCreated struct {
+	pb_social.UnimplementedStatisticStatItemCreatedServiceServer
+
+	pipelineManager *pipeline.Manager
+	namespace       string
+}
+
+// NewStatItemCreated creates a new StatItemCreated event listener
+func NewStatItemCreated(pipelineManager *pipeline.Manager, namespace string) *StatItemCreated {
+	return &StatItemCreated{
+		pipelineManager: pipelineManager,
+		namespace:       namespace,
+	}
+}
+
+// OnMessage handles statItemCreated events
+// This is called when a stat is updated for a player for the first time
+func (s *StatItemCreated) OnMessage(
+	ctx context.Context,
+	msg *pb_social.StatItemCreated,
+) (*emptypb.Empty, error) {
+	scope := common.GetScopeFromContext(ctx, "StatItemCreated.OnMessage")
+	defer scope.Finish()
+
+	userID := msg.GetUserId()
+	if userID == "" {
+		userID = msg.GetPayload().GetUserId()
+	}
+	return processStatLifecycleEvent(ctx, s.pipelineManager, signalBuiltin.EventTypeStatItemCreated, msg,
+		userID, msg.GetPayload().GetStatCode(), msg.GetNamespace())
+}
+
+// StatItemDeleted listens for stat item deleted events
+type StatItemDeleted struct {
+	pb_social.UnimplementedStatisticStatItemDeletedServiceServer
+
+	pipelineManager *pipeline.Manager
+	namespace       string
+}
+
+// NewStatItemDeleted creates a new StatItemDeleted event listener
+func NewStatItemDeleted(pipelineManager *pipeline.Manager, namespace string) *StatItemDeleted {
+	return &StatItemDeleted{
+		pipelineManager: pipelineManager,
+		namespace:       namespace,
+	}
+}
+
+// OnMessage handles statItemDeleted events
+// This is called when a player's stat item is deleted, which resets the stat
+func (s *StatItemDeleted) OnMessage(
+	ctx context.Context,
+	msg *pb_social.StatItemDeleted,
+) (*emptypb.Empty, error) {
+	scope := common.GetScopeFromContext(ctx, "StatItemDeleted.OnMessage")
+	defer scope.Finish()
+
+	userID := msg.GetUserId()
+	if userID == "" {
+		userID = msg.GetPayload().GetUserId()
+	}
+	return processStatLifecycleEvent(ctx, s.pipelineManager, signalBuiltin.EventTypeStatItemDeleted, msg,
+		userID, msg.GetPayload().GetStatCode(), msg.GetNamespace())
+}
+
+// StatItemCycleUpdated listens for stat item cycle updated events
+type StatItemCycleUpdated struct {
+	pb_social.UnimplementedStatisticCycleStatItemCycleUpdatedServiceServer
+
+	pipelineManager *pipeline.Manager
+	namespace       string
+}
+
+// NewStatItemCycleUpdated creates a new StatItemCycleUpdated event listener
+func NewStatItemCycleUpdated(pipelineManager *pipeline.Manager, namespace string) *StatItemCycleUpdated {
+	return &StatItemCycleUpdated{
+		pipelineManager: pipelineManager,
+		namespace:       namespace,
+	}
+}
+
+// OnMessage handles statItemCycleUpdated events
+// This is called when a player's stat is updated within a stat cycle (e.g. a season)
+func (s *StatItemCycleUpdated) OnMessage(
+	ctx context.Context,
+	msg *pb_social.StatItemCycleUpdated,
+) (*emptypb.Empty, error) {
+	scope := common.GetScopeFromContext(ctx, "StatItemCycleUpdated.OnMessage")
+	defer scope.Finish()
+
+	userID := msg.GetUserId()
+	if userID == "" {
+		userID = msg.GetPayload().GetUserId()
+	}
+	return processStatLifecycleEvent(ctx, s.pipelineManager, signalBuiltin.EventTypeStatItemCycleUpdated, msg,
+		userID, msg.GetPayload().GetStatCode(), msg.GetNamespace())
+}
+
+// StatCycleReset listens for stat cycle reset events
+type StatCycleReset struct {
+	pb_social.UnimplementedStatCycleManagementStatCycleResetServiceServer
+
+	pipelineManager *pipeline.Manager
+	namespace       string
+}
+
+// NewStatCycleReset creates a new StatCycleReset event listener
+func NewStatCycleReset(pipelineManager *pipeline.Manager, namespace string) *StatCycleReset {
+	return &StatCycleReset{
+		pipelineManager: pipelineManager,
+		namespace:       namespace,
+	}
+}
+
+// OnMessage handles statCycleReset events
+// This is called when a stat cycle (e.g. a season) is reset for all players
+func (s *StatCycleReset) OnMessage(
+	ctx context.Context,
+	msg *pb_social.StatCycleReset,
+) (*emptypb.Empty, error) {
+	scope := common.GetScopeFromContext(ctx, "StatCycleReset.OnMessage")
+	defer scope.Finish()
+
+	cycleID := msg.GetPayload().GetId()
+	logrus.Infof("received stat cycle reset event: cycleId=%s version=%d namespace=%s",
+		cycleID, msg.GetPayload().GetCurrentVersion(), msg.GetNamespace())
+
+	if err := s.pipelineManager.ProcessEvent(ctx, signalBuiltin.EventTypeStatCycleReset, msg); err != nil {
+		logrus.Errorf("pipeline processing failed for stat cycle %s: %v", cycleID, err)
+		return &emptypb.Empty{}, status.Errorf(codes.Internal,
+			"pipeline processing failed: %v", err)
+	}
+
+	logrus.Infof("successfully processed stat cycle reset of cycle %s", cycleID)
+	return &emptypb.Empty{}, nil
+}
+
+// processStatLifecycleEvent feeds a player's stat lifecycle event into the pipeline.
+// Events without a user ID are logged and dropped, like stat updates.
+func processStatLifecycleEvent(
+	ctx context.Context,
+	pipelineManager *pipeline.Manager,
+	eventType string,
+	msg interface{},
+	userID, statCode, namespace string,
+) (*emptypb.Empty, error) {
+	if userID == "" {
+		logrus.Warnf("received %s event with empty user_id", eventType)
+		return &emptypb.Empty{}, nil
+	}
+
+	logrus.Infof("received %s event: userId=%s statCode=%s namespace=%s",
+		eventType, userID, statCode, namespace)
+
+	if err := pipelineManager.ProcessEvent(ctx, eventType, msg); err != nil {
+		logrus.Errorf("pipeline processing failed for user %s: %v", userID, err)
+		return &emptypb.Empty{}, status.Errorf(codes.Internal,
+			"pipeline processing failed: %v", err)
+	}
+
+	logrus.Infof("successfully processed %s event for user %s: %s", eventType, userID, statCode)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	pb_social "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestStatLifecycle_OnMessage_ProcessesEvents(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	pipelineManager := setupTestPipeline("test-namespace", mr)
+	ctx := context.Background()
+	statItem := &pb_social.StatItem{UserId: "test-user", StatCode: "rse-current-losing-streak"}
+
+	if _, err := NewStatItemCreated(pipelineManager, "test-namespace").OnMessage(ctx,
+		&pb_social.StatItemCreated{Namespace: "test-namespace", Payload: statItem}); err != nil {
+		t.Errorf("StatItemCreated.OnMessage() error = %v", err)
+	}
+	if _, err := NewStatItemDeleted(pipelineManager, "test-namespace").OnMessage(ctx,
+		&pb_social.StatItemDeleted{Namespace: "test-namespace", Payload: statItem}); err != nil {
+		t.Errorf("StatItemDeleted.OnMessage() error = %v", err)
+	}
+	if _, err := NewStatCycleReset(pipelineManager, "test-namespace").OnMessage(ctx,
+		&pb_social.StatCycleReset{Namespace: "test-namespace", Payload: &pb_social.StatCycle{Id: "season", CurrentVersion: 2}}); err != nil {
+		t.Errorf("StatCycleReset.OnMessage() error = %v", err)
+	}
+	if _, err := NewStatItemCycleUpdated(pipelineManager, "test-namespace").OnMessage(ctx,
+		&pb_social.StatItemCycleUpdated{Namespace: "test-namespace", Payload: &pb_social.StatCycleItem{
+			UserId: "test-user", CycleId: "season", StatCode: "rse-current-losing-streak", CycleVersion: 2,
+		}}); err != nil {
+		t.Errorf("StatItemCycleUpdated.OnMessage() error = %v", err)
+	}
+}
+
+func TestStatLifecycle_OnMessage_EmptyUserID(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	listener := NewStatItemDeleted(setupTestPipeline("test-namespace", mr), "test-namespace")
+
+	// Events without a user ID are dropped, not failed
+	if _, err := listener.OnMessage(context.Background(), &pb_social.StatItemDeleted{
+		Payload: &pb_social.StatItem{StatCode: "rse-current-losing-streak"},
+	}); err != nil {
+		t.Errorf("OnMessage() error = %v", err)
+	}
+}
@@ -31,6 +31,8 @@ func setupTestPipeline(namespace string, mr *miniredis.Miniredis) *pipeline.Mana
 		processor.GetNamespace(),
 		&signalBuiltin.EventProcessorDependencies{
 			LoginTrackingStore: loginSessionTrackingStore,
+			StatCycleStore:     service.NewRedisStatCycleStore(client),
+			SessionTracker:     service.NewRedisSessionTracker(client),
 		},
 	)
 
@@ -0,0 +1,192 @@
+// Package health tracks whether the service is ready to receive events and whether
+// it is alive. Readiness checks cover the dependencies events cannot be processed
+// without; liveness checks detect a process that is running but no longer making
+// progress and needs a restart.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultCheckTimeout bounds a single check when the checker has no timeout.
+const DefaultCheckTimeout = 5 * time.Second
+
+// Check returns an error describing why a dependency is unhealthy, or nil.
+type Check func(ctx context.Context) error
+
+// CheckResult is the outcome of one check.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Report is the outcome of a set of checks. It is healthy when every check is.
+type Report struct {
+	Healthy   bool          `json:"healthy"`
+	CheckedAt time.Time     `json:"checked_at"`
+	Checks    []CheckResult `json:"checks"`
+}
+
+// namedCheck is a registered check.
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs readiness and liveness checks and keeps their latest reports.
+// Until the first CheckNow both reports are unhealthy.
+type Checker struct {
+	timeout time.Duration
+
+	mu        sync.RWMutex
+	readiness []namedCheck
+	liveness  []namedCheck
+	ready     Report
+	live      Report
+	checked   bool
+	listeners []func(ready, live bool)
+}
+
+// NewChecker creates a checker that gives each check up to timeout to complete.
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultCheckTimeout
+	}
+	return &Checker{timeout: timeout}
+}
+
+// AddReadinessCheck registers a check the service must pass to receive events.
+func (c *Checker) AddReadinessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readiness = append(c.readiness, namedCheck{name: name, check: check})
+}
+
+// AddLivenessCheck registers a check whose failure means the service must be restarted.
+func (c *Checker) AddLivenessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.liveness = append(c.liveness, namedCheck{name: name, check: check})
+}
+
+// OnChange registers fn to be called after a CheckNow that changed the readiness or
+// liveness of the service, and after the first CheckNow.
+func (c *Checker) OnChange(fn func(ready, live bool)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, fn)
+}
+
+// Readiness returns the latest readiness report.
+func (c *Checker) Readiness() Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ready
+}
+
+// Liveness returns the latest liveness report.
+func (c *Checker) Liveness() Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.live
+}
+
+// CheckNow runs all checks and updates the reports.
+func (c *Checker) CheckNow(ctx context.Context) {
+	c.mu.RLock()
+	readiness, liveness := c.readiness, c.liveness
+	c.mu.RUnlock()
+
+	ready := c.run(ctx, readiness)
+	live := c.run(ctx, liveness)
+
+	c.mu.Lock()
+	changed := !c.checked || ready.Healthy != c.ready.Healthy || live.Healthy != c.live.Healthy
+	c.ready, c.live, c.checked = ready, live, true
+	listeners := c.listeners
+	c.mu.Unlock()
+
+	if changed {
+		for _, fn := range listeners {
+			fn(ready.Healthy, live.Healthy)
+		}
+	}
+}
+
+// Run checks every interval until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.CheckNow(ctx)
+		}
+	}
+}
+
+// run executes checks concurrently, so one hanging dependency cannot delay the others.
+func (c *Checker) run(ctx context.Context, checks []namedCheck) Report {
+	report := Report{
+		Healthy:   true,
+		CheckedAt: time.Now(),
+		Checks:    make([]CheckResult, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, nc := range checks {
+		wg.Add(1)
+		go func(i int, nc namedCheck) {
+			defer wg.Done()
+			report.Checks[i] = c.runOne(ctx, nc)
+		}(i, nc)
+	}
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if !result.Healthy {
+			report.Healthy = false
+		}
+	}
+	return report
+}
+
+// runOne executes a check with the checker timeout, recovering from panics.
+func (c *Checker) runOne(ctx context.Context, nc namedCheck) (result CheckResult) {
+	result.Name = nc.name
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	errs := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				errs <- fmt.Errorf("check panicked: %v", r)
+			}
+		}()
+		errs <- nc.check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errs:
+	case <-ctx.Done():
+		// Checks that ignore their context must not hang the checker
+		err = fmt.Errorf("check timed out after %v", c.timeout)
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Healthy = true
+	return result
+}
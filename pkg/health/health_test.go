@@ -0,0 +1,112 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChecker_UnhealthyUntilChecked(t *testing.T) {
+	c := NewChecker(time.Second)
+	c.AddReadinessCheck("redis", func(ctx context.Context) error { return nil })
+
+	if c.Readiness().Healthy || c.Liveness().Healthy {
+		t.Fatal("expected reports to be unhealthy before the first check")
+	}
+
+	c.CheckNow(context.Background())
+
+	if !c.Readiness().Healthy || !c.Liveness().Healthy {
+		t.Errorf("expected healthy reports, got %+v and %+v", c.Readiness(), c.Liveness())
+	}
+}
+
+func TestChecker_FailingCheck(t *testing.T) {
+	c := NewChecker(time.Second)
+	c.AddReadinessCheck("redis", func(ctx context.Context) error { return nil })
+	c.AddReadinessCheck("token", func(ctx context.Context) error { return errors.New("access token expired") })
+	c.AddLivenessCheck("pipeline", func(ctx context.Context) error { return nil })
+
+	c.CheckNow(context.Background())
+
+	ready := c.Readiness()
+	if ready.Healthy {
+		t.Error("expected readiness to fail")
+	}
+	expected := []CheckResult{
+		{Name: "redis", Healthy: true},
+		{Name: "token", Error: "access token expired"},
+	}
+	if len(ready.Checks) != len(expected) {
+		t.Fatalf("expected %d results, got %+v", len(expected), ready.Checks)
+	}
+	for i := range expected {
+		if ready.Checks[i] != expected[i] {
+			t.Errorf("result %d: expected %+v, got %+v", i, expected[i], ready.Checks[i])
+		}
+	}
+
+	if !c.Liveness().Healthy {
+		t.Error("expected liveness to be unaffected by readiness checks")
+	}
+}
+
+func TestChecker_TimeoutAndPanic(t *testing.T) {
+	c := NewChecker(20 * time.Millisecond)
+	block := make(chan struct{})
+	defer close(block)
+	c.AddReadinessCheck("hang", func(ctx context.Context) error {
+		<-block // Ignores its context
+		return nil
+	})
+	c.AddReadinessCheck("panic", func(ctx context.Context) error { panic("boom") })
+
+	done := make(chan struct{})
+	go func() {
+		c.CheckNow(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("CheckNow blocked on a hanging check")
+	}
+
+	checks := c.Readiness().Checks
+	if !strings.Contains(checks[0].Error, "timed out") {
+		t.Errorf("expected a timeout, got %+v", checks[0])
+	}
+	if !strings.Contains(checks[1].Error, "panicked: boom") {
+		t.Errorf("expected a panic, got %+v", checks[1])
+	}
+}
+
+func TestChecker_OnChange(t *testing.T) {
+	c := NewChecker(time.Second)
+	var healthy error
+	c.AddReadinessCheck("redis", func(ctx context.Context) error { return healthy })
+
+	type change struct{ ready, live bool }
+	var changes []change
+	c.OnChange(func(ready, live bool) { changes = append(changes, change{ready, live}) })
+
+	c.CheckNow(context.Background())
+	c.CheckNow(context.Background())
+	healthy = errors.New("connection refused")
+	c.CheckNow(context.Background())
+	c.CheckNow(context.Background())
+	healthy = nil
+	c.CheckNow(context.Background())
+
+	expected := []change{{true, true}, {false, true}, {true, true}}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected changes %v, got %v", expected, changes)
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Errorf("change %d: expected %v, got %v", i, expected[i], changes[i])
+		}
+	}
+}
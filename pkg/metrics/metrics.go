@@ -0,0 +1,242 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package metrics defines the Prometheus metrics emitted by the churn intervention pipeline.
+// Metrics are registered on the metrics server registry in internal/server/metrics.go.
+// Counters of events, rules, actions and configurations are labelled with the AGS namespace
+// they concern, so that the games served by one deployment can be told apart.
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// DuplicateEventsDroppedTotal counts events skipped because their event ID was already processed.
+var DuplicateEventsDroppedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_duplicate_events_dropped_total",
+		Help: "Total number of redelivered events dropped by deduplication",
+	},
+	[]string{"namespace", "event_type"},
+)
+
+// LateEventsDroppedTotal counts events skipped because they occurred longer than the lateness window ago.
+var LateEventsDroppedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_late_events_dropped_total",
+		Help: "Total number of events dropped for arriving later than the lateness window",
+	},
+	[]string{"namespace", "event_type"},
+)
+
+// LaneQueueDepth reports the number of events waiting on each per-player ordered lane.
+var LaneQueueDepth = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "churn_intervention_lane_queue_depth",
+		Help: "Number of events waiting on each pipeline lane",
+	},
+	[]string{"lane"},
+)
+
+// LaneQueueLatencySeconds measures how long events wait on a lane before processing starts.
+var LaneQueueLatencySeconds = prometheus.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    "churn_intervention_lane_queue_latency_seconds",
+		Help:    "Time events spend queued on a pipeline lane before processing",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	},
+)
+
+// ActionRetriesTotal counts action attempts that were retried after a retryable failure.
+var ActionRetriesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_action_retries_total",
+		Help: "Total number of action retries after a retryable failure",
+	},
+	[]string{"action_id"},
+)
+
+// ActionRetriesExhaustedTotal counts actions that still failed after all configured attempts.
+var ActionRetriesExhaustedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_action_retries_exhausted_total",
+		Help: "Total number of actions that failed after exhausting their retry attempts",
+	},
+	[]string{"action_id"},
+)
+
+// AsyncActionQueueDepth reports the number of async actions waiting for a worker.
+var AsyncActionQueueDepth = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "churn_intervention_async_action_queue_depth",
+		Help: "Number of async actions waiting for a worker",
+	},
+)
+
+// AsyncActionQueueFullTotal counts async actions executed inline because the queue was full.
+var AsyncActionQueueFullTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_async_action_queue_full_total",
+		Help: "Total number of async actions executed inline because the async queue was full",
+	},
+	[]string{"action_id"},
+)
+
+// AsyncActionFailuresTotal counts async actions that failed or were discarded at shutdown.
+var AsyncActionFailuresTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_async_action_failures_total",
+		Help: "Total number of async actions that failed or were discarded at shutdown",
+	},
+	[]string{"action_id"},
+)
+
+// RuleCooldownSuppressedTotal counts rule matches suppressed because the rule was on cooldown.
+var RuleCooldownSuppressedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_rule_cooldown_suppressed_total",
+		Help: "Total number of rule matches suppressed because the rule was on cooldown",
+	},
+	[]string{"namespace", "rule_id"},
+)
+
+// PipelineConfigInfo reports the version of the active pipeline configuration of each namespace.
+// Exactly one series per namespace, labelled with its current version, is set to 1.
+var PipelineConfigInfo = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "churn_intervention_pipeline_config_info",
+		Help: "Version of the active pipeline configuration (always 1)",
+	},
+	[]string{"namespace", "version"},
+)
+
+// PipelineConfigReloadsTotal counts pipeline configuration reload attempts by result.
+var PipelineConfigReloadsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_pipeline_config_reloads_total",
+		Help: "Total number of pipeline configuration reload attempts",
+	},
+	[]string{"namespace", "result"},
+)
+
+// PipelineConfigReloadFailing is 1 while the pipeline configuration file of a namespace does not
+// load, i.e. the namespace runs an older configuration than the one deployed, and 0 otherwise.
+var PipelineConfigReloadFailing = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "churn_intervention_pipeline_config_reload_failing",
+		Help: "Whether the deployed pipeline configuration fails to load (1) or is running (0)",
+	},
+	[]string{"namespace"},
+)
+
+// RuleShadowTriggersTotal counts would-be triggers of rules running in shadow mode.
+var RuleShadowTriggersTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_rule_shadow_triggers_total",
+		Help: "Total number of triggers of rules in shadow mode (no live actions executed)",
+	},
+	[]string{"namespace", "rule_id"},
+)
+
+// ShadowActionExecutionsTotal counts actions recorded instead of executed because they run in shadow mode.
+var ShadowActionExecutionsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_shadow_action_executions_total",
+		Help: "Total number of actions recorded but not executed because of shadow mode",
+	},
+	[]string{"namespace", "action_id", "rule_id"},
+)
+
+// EventsReceivedTotal counts events received by the pipeline per handler and stat code.
+// The stat code is empty for events other than stat updates.
+var EventsReceivedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_events_received_total",
+		Help: "Total number of events received by the pipeline",
+	},
+	[]string{"namespace", "handler", "stat_code"},
+)
+
+// SignalProcessingDurationSeconds measures how long converting an event into a signal takes,
+// including loading the player's churn state.
+var SignalProcessingDurationSeconds = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "churn_intervention_signal_processing_duration_seconds",
+		Help:    "Time taken to convert an event into a signal",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	},
+	[]string{"handler"},
+)
+
+// RuleEvaluationsTotal counts rule evaluations per rule.
+var RuleEvaluationsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_rule_evaluations_total",
+		Help: "Total number of rule evaluations",
+	},
+	[]string{"namespace", "rule_id"},
+)
+
+// RuleTriggersTotal counts live rule triggers per rule. Shadow triggers are
+// counted separately in RuleShadowTriggersTotal.
+var RuleTriggersTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_rule_triggers_total",
+		Help: "Total number of rule triggers",
+	},
+	[]string{"namespace", "rule_id"},
+)
+
+// ActionExecutionsTotal counts action executions per action. An execution and its retries count once.
+var ActionExecutionsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_action_executions_total",
+		Help: "Total number of action executions",
+	},
+	[]string{"namespace", "action_id"},
+)
+
+// ActionFailuresTotal counts action executions that failed after all retry attempts.
+var ActionFailuresTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_action_failures_total",
+		Help: "Total number of failed action executions",
+	},
+	[]string{"namespace", "action_id"},
+)
+
+// ActionRollbacksTotal counts action rollbacks per action.
+var ActionRollbacksTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_action_rollbacks_total",
+		Help: "Total number of action rollbacks",
+	},
+	[]string{"namespace", "action_id"},
+)
+
+// ActionExecutionDurationSeconds measures action execution time, including retries.
+var ActionExecutionDurationSeconds = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "churn_intervention_action_execution_duration_seconds",
+		Help:    "Time taken to execute an action, including retries",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
+	},
+	[]string{"action_id"},
+)
+
+// StateStoreDurationSeconds measures churn state store latency per operation ("get", "update" or "delete").
+var StateStoreDurationSeconds = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "churn_intervention_state_store_duration_seconds",
+		Help:    "Latency of churn state store operations",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+	},
+	[]string{"operation"},
+)
+
+// AuditWriteFailuresTotal counts decision audit records that could not be written to the audit sink.
+var AuditWriteFailuresTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "churn_intervention_audit_write_failures_total",
+		Help: "Total number of decision audit records that could not be written",
+	},
+)
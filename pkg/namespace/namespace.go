@@ -0,0 +1,53 @@
+// Package namespace carries the AGS namespace of the event being processed.
+//
+// One deployment can serve several namespaces (games) of a publisher. The pipeline
+// scopes the context of each event to the event's namespace; stores scope their keys
+// with Key, AGS clients call the namespace's APIs and metrics are labelled with it.
+package namespace
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoNamespace is returned by Key for contexts that are not scoped to a namespace.
+var ErrNoNamespace = errors.New("context is not scoped to a namespace")
+
+type contextKey struct{}
+
+// scope is the namespace a context is scoped to.
+type scope struct {
+	namespace string
+	// isDefault is set for the namespace of the deployment (AB_NAMESPACE)
+	isDefault bool
+}
+
+// NewContext returns a copy of ctx scoped to namespace. defaultNamespace is the
+// namespace of the deployment, whose keys are not scoped (see Key).
+func NewContext(ctx context.Context, namespace, defaultNamespace string) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{
+		namespace: namespace,
+		isDefault: namespace == defaultNamespace,
+	})
+}
+
+// FromContext returns the namespace ctx is scoped to, or "" if it is not scoped.
+func FromContext(ctx context.Context) string {
+	s, _ := ctx.Value(contextKey{}).(scope)
+	return s.namespace
+}
+
+// Key scopes a storage key to the namespace of ctx by prefixing it with "<namespace>:".
+// Keys of the default namespace are returned as is, so that state written before
+// namespaces were scoped stays where it was. A context without namespace fails with
+// ErrNoNamespace instead of falling back to the default namespace's keys.
+func Key(ctx context.Context, key string) (string, error) {
+	s, ok := ctx.Value(contextKey{}).(scope)
+	if ok && s.isDefault {
+		return key, nil
+	}
+	if !ok || s.namespace == "" {
+		return "", ErrNoNamespace
+	}
+	return s.namespace + ":" + key, nil
+}
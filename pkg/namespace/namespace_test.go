@@ -0,0 +1,37 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		wantNamespace string
+		wantKey       string
+		wantErr       error
+	}{
+		{"no namespace", context.Background(), "", "", ErrNoNamespace},
+		{"empty namespace", NewContext(context.Background(), "", "game-a"), "", "", ErrNoNamespace},
+		{"default namespace", NewContext(context.Background(), "game-a", "game-a"), "game-a", "user123", nil},
+		{"other namespace", NewContext(context.Background(), "game-b", "game-a"), "game-b", "game-b:user123", nil},
+		{"rescoped", NewContext(NewContext(context.Background(), "game-b", "game-a"), "game-a", "game-a"), "game-a", "user123", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromContext(tt.ctx); got != tt.wantNamespace {
+				t.Errorf("FromContext() = %q, want %q", got, tt.wantNamespace)
+			}
+			got, err := Key(tt.ctx, "user123")
+			if err != tt.wantErr {
+				t.Errorf("Key() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.wantKey {
+				t.Errorf("Key() = %q, want %q", got, tt.wantKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+// Package param declares the parameters of rule and action types.
+//
+// Each rule and action type registers a Schema of the parameters it reads. Parameters
+// from pipeline.yaml are validated against it when rules and actions are created, so a
+// wrongly typed, out of range, missing or misspelled parameter fails the load instead of
+// silently falling back to a default. Schemas are also exported as JSON Schema for
+// editor autocompletion of pipeline.yaml.
+package param
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Type is the type of a parameter value in YAML.
+type Type string
+
+// Parameter types.
+const (
+	TypeInt        Type = "integer"
+	TypeNumber     Type = "number" // Integers are accepted too
+	TypeString     Type = "string"
+	TypeBool       Type = "boolean"
+	TypeStringList Type = "string_list"
+)
+
+// Spec declares one parameter.
+type Spec struct {
+	Name        string
+	Type        Type
+	Required    bool
+	Default     interface{} // Value used when the parameter is absent, for documentation
+	Min         *float64    // Inclusive bounds of integer and number parameters
+	Max         *float64
+	Description string
+}
+
+// Schema declares the parameters of a rule or action type.
+// A nil Schema declares nothing and accepts any parameters; an empty Schema accepts none.
+type Schema []Spec
+
+// Bound returns a pointer to v, for Spec.Min and Spec.Max.
+func Bound(v float64) *float64 {
+	return &v
+}
+
+// Error is a problem with one parameter.
+type Error struct {
+	Param   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Param + " " + e.Message
+}
+
+// Errors are all the problems found with a set of parameters.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks params against the schema and returns Errors listing every problem,
+// or nil if they are valid.
+func (s Schema) Validate(params map[string]interface{}) error {
+	if s == nil {
+		return nil
+	}
+
+	var errs Errors
+	specs := make(map[string]bool, len(s))
+	for _, spec := range s {
+		specs[spec.Name] = true
+
+		value, ok := params[spec.Name]
+		if !ok || value == nil {
+			if spec.Required {
+				errs = append(errs, &Error{Param: spec.Name, Message: "is required"})
+			}
+			continue
+		}
+
+		if message := spec.check(value); message != "" {
+			errs = append(errs, &Error{Param: spec.Name, Message: message})
+		}
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		if !specs[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		errs = append(errs, &Error{Param: name, Message: "is not a known parameter" + s.suggest()})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// suggest lists the known parameters for an unknown parameter error.
+func (s Schema) suggest() string {
+	if len(s) == 0 {
+		return " (this type takes no parameters)"
+	}
+	names := make([]string, len(s))
+	for i, spec := range s {
+		names[i] = spec.Name
+	}
+	return " (expected " + strings.Join(names, ", ") + ")"
+}
+
+// check returns why value does not match the spec, or "" if it does.
+func (p Spec) check(value interface{}) string {
+	var number float64
+	switch p.Type {
+	case TypeInt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Sprintf("must be an integer, got %s", describe(value))
+		}
+		number = float64(v)
+	case TypeNumber:
+		switch v := value.(type) {
+		case int:
+			number = float64(v)
+		case float64:
+			number = v
+		default:
+			return fmt.Sprintf("must be a number, got %s", describe(value))
+		}
+	case TypeString:
+		if _, ok := value.(string); !ok {
+			return fmt.Sprintf("must be a string, got %s", describe(value))
+		}
+		return ""
+	case TypeBool:
+		if _, ok := value.(bool); !ok {
+			return fmt.Sprintf("must be a boolean, got %s", describe(value))
+		}
+		return ""
+	case TypeStringList:
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Sprintf("must be a list of strings, got %s", describe(value))
+		}
+		for i, item := range items {
+			if _, ok := item.(string); !ok {
+				return fmt.Sprintf("must be a list of strings, item %d is %s", i, describe(item))
+			}
+		}
+		return ""
+	default:
+		return fmt.Sprintf("has unknown type %q in its schema", p.Type)
+	}
+
+	if p.Min != nil && number < *p.Min {
+		return fmt.Sprintf("must be at least %v, got %v", *p.Min, number)
+	}
+	if p.Max != nil && number > *p.Max {
+		return fmt.Sprintf("must be at most %v, got %v", *p.Max, number)
+	}
+	return ""
+}
+
+// describe names the YAML type and value of a decoded parameter for error messages.
+func describe(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case int:
+		return fmt.Sprintf("integer %d", v)
+	case float64:
+		if v == math.Trunc(v) {
+			return fmt.Sprintf("number %.1f", v) // Show that 3.0 is not the integer 3
+		}
+		return fmt.Sprintf("number %v", v)
+	case bool:
+		return fmt.Sprintf("boolean %v", v)
+	case []interface{}:
+		return "a list"
+	case map[string]interface{}:
+		return "a mapping"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// JSONSchema returns the JSON Schema of a parameters object matching the schema.
+// A nil Schema allows any object.
+func (s Schema) JSONSchema() map[string]interface{} {
+	if s == nil {
+		return map[string]interface{}{"type": "object"}
+	}
+
+	properties := make(map[string]interface{}, len(s))
+	required := []string{}
+	for _, spec := range s {
+		property := map[string]interface{}{}
+		switch spec.Type {
+		case TypeStringList:
+			property["type"] = "array"
+			property["items"] = map[string]interface{}{"type": "string"}
+		default:
+			property["type"] = string(spec.Type)
+		}
+		if spec.Description != "" {
+			property["description"] = spec.Description
+		}
+		if spec.Default != nil {
+			property["default"] = spec.Default
+		}
+		if spec.Min != nil {
+			property["minimum"] = *spec.Min
+		}
+		if spec.Max != nil {
+			property["maximum"] = *spec.Max
+		}
+
+		properties[spec.Name] = property
+		if spec.Required {
+			required = append(required, spec.Name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,116 @@
+package param
+
+import (
+	"errors"
+	"testing"
+)
+
+var testSchema = Schema{
+	{Name: "threshold", Type: TypeInt, Default: 5, Min: Bound(1)},
+	{Name: "ratio", Type: TypeNumber, Min: Bound(0), Max: Bound(1)},
+	{Name: "item_id", Type: TypeString, Required: true},
+	{Name: "notify", Type: TypeBool},
+	{Name: "tags", Type: TypeStringList},
+}
+
+func TestSchema_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]interface{}
+		expected string
+	}{
+		{
+			name:   "valid",
+			params: map[string]interface{}{"threshold": 3, "ratio": 0.5, "item_id": "ITEM", "notify": true, "tags": []interface{}{"a", "b"}},
+		},
+		{
+			name:   "integer accepted as number",
+			params: map[string]interface{}{"ratio": 1, "item_id": "ITEM"},
+		},
+		{
+			name:     "string for integer",
+			params:   map[string]interface{}{"threshold": "5", "item_id": "ITEM"},
+			expected: `threshold must be an integer, got string "5"`,
+		},
+		{
+			name:     "float for integer",
+			params:   map[string]interface{}{"threshold": 3.0, "item_id": "ITEM"},
+			expected: "threshold must be an integer, got number 3.0",
+		},
+		{
+			name:     "out of range",
+			params:   map[string]interface{}{"threshold": 0, "ratio": 1.5, "item_id": "ITEM"},
+			expected: "threshold must be at least 1, got 0; ratio must be at most 1, got 1.5",
+		},
+		{
+			name:     "missing required",
+			params:   map[string]interface{}{"threshold": 3},
+			expected: "item_id is required",
+		},
+		{
+			name:     "wrong list item",
+			params:   map[string]interface{}{"item_id": "ITEM", "tags": []interface{}{"a", 1}},
+			expected: "tags must be a list of strings, item 1 is integer 1",
+		},
+		{
+			name:     "unknown parameter",
+			params:   map[string]interface{}{"item_id": "ITEM", "treshold": 3},
+			expected: "treshold is not a known parameter (expected threshold, ratio, item_id, notify, tags)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testSchema.Validate(tt.params)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("expected error %q, got %v", tt.expected, err)
+			}
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Errorf("expected Errors, got %T", err)
+			}
+		})
+	}
+}
+
+func TestSchema_ValidateNilAndEmpty(t *testing.T) {
+	params := map[string]interface{}{"anything": 1}
+
+	if err := Schema(nil).Validate(params); err != nil {
+		t.Errorf("expected a nil schema to accept any parameters, got %v", err)
+	}
+
+	err := Schema{}.Validate(params)
+	if err == nil || err.Error() != "anything is not a known parameter (this type takes no parameters)" {
+		t.Errorf("expected an empty schema to reject parameters, got %v", err)
+	}
+}
+
+func TestSchema_JSONSchema(t *testing.T) {
+	schema := testSchema.JSONSchema()
+
+	if schema["additionalProperties"] != false {
+		t.Error("expected additional properties to be rejected")
+	}
+	required, _ := schema["required"].([]string)
+	if len(required) != 1 || required[0] != "item_id" {
+		t.Errorf("expected item_id to be required, got %v", schema["required"])
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	threshold := properties["threshold"].(map[string]interface{})
+	if threshold["type"] != "integer" || threshold["default"] != 5 || threshold["minimum"] != 1.0 {
+		t.Errorf("unexpected threshold schema: %v", threshold)
+	}
+	tags := properties["tags"].(map[string]interface{})
+	if tags["type"] != "array" {
+		t.Errorf("expected tags to be an array, got %v", tags)
+	}
+}
@@ -0,0 +1,1145 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.31.1
+// source: churn-intervention/admin/v1/admin.proto
+
+package admin
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChurnState mirrors service.ChurnState.
+type ChurnState struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SignalHistory       []*ChurnSignal         `protobuf:"bytes,1,rep,name=signal_history,json=signalHistory,proto3" json:"signal_history,omitempty"`
+	InterventionHistory []*InterventionRecord  `protobuf:"bytes,2,rep,name=intervention_history,json=interventionHistory,proto3" json:"intervention_history,omitempty"`
+	Cooldown            *CooldownState         `protobuf:"bytes,3,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	ShadowHistory       []*ShadowRecord        `protobuf:"bytes,4,rep,name=shadow_history,json=shadowHistory,proto3" json:"shadow_history,omitempty"`
+	Revision            int64                  `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChurnState) Reset() {
+	*x = ChurnState{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChurnState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChurnState) ProtoMessage() {}
+
+func (x *ChurnState) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChurnState.ProtoReflect.Descriptor instead.
+func (*ChurnState) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChurnState) GetSignalHistory() []*ChurnSignal {
+	if x != nil {
+		return x.SignalHistory
+	}
+	return nil
+}
+
+func (x *ChurnState) GetInterventionHistory() []*InterventionRecord {
+	if x != nil {
+		return x.InterventionHistory
+	}
+	return nil
+}
+
+func (x *ChurnState) GetCooldown() *CooldownState {
+	if x != nil {
+		return x.Cooldown
+	}
+	return nil
+}
+
+func (x *ChurnState) GetShadowHistory() []*ShadowRecord {
+	if x != nil {
+		return x.ShadowHistory
+	}
+	return nil
+}
+
+func (x *ChurnState) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ChurnSignal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChurnSignal) Reset() {
+	*x = ChurnSignal{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChurnSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChurnSignal) ProtoMessage() {}
+
+func (x *ChurnSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChurnSignal.ProtoReflect.Descriptor instead.
+func (*ChurnSignal) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChurnSignal) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChurnSignal) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *ChurnSignal) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ChurnSignal) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type InterventionRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TriggeredBy   string                 `protobuf:"bytes,3,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+	TriggeredAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Outcome       string                 `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	OutcomeAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=outcome_at,json=outcomeAt,proto3" json:"outcome_at,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterventionRecord) Reset() {
+	*x = InterventionRecord{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterventionRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterventionRecord) ProtoMessage() {}
+
+func (x *InterventionRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterventionRecord.ProtoReflect.Descriptor instead.
+func (*InterventionRecord) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InterventionRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InterventionRecord) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InterventionRecord) GetTriggeredBy() string {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return ""
+}
+
+func (x *InterventionRecord) GetTriggeredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return nil
+}
+
+func (x *InterventionRecord) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *InterventionRecord) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *InterventionRecord) GetOutcomeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OutcomeAt
+	}
+	return nil
+}
+
+func (x *InterventionRecord) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CooldownState struct {
+	state              protoimpl.MessageState            `protogen:"open.v1"`
+	LastInterventionAt *timestamppb.Timestamp            `protobuf:"bytes,1,opt,name=last_intervention_at,json=lastInterventionAt,proto3" json:"last_intervention_at,omitempty"`
+	CooldownUntil      *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=cooldown_until,json=cooldownUntil,proto3" json:"cooldown_until,omitempty"`
+	InterventionCounts map[string]int64                  `protobuf:"bytes,3,rep,name=intervention_counts,json=interventionCounts,proto3" json:"intervention_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	LastSignalAt       map[string]*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=last_signal_at,json=lastSignalAt,proto3" json:"last_signal_at,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OnCooldown         bool                              `protobuf:"varint,5,opt,name=on_cooldown,json=onCooldown,proto3" json:"on_cooldown,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CooldownState) Reset() {
+	*x = CooldownState{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CooldownState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CooldownState) ProtoMessage() {}
+
+func (x *CooldownState) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CooldownState.ProtoReflect.Descriptor instead.
+func (*CooldownState) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CooldownState) GetLastInterventionAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastInterventionAt
+	}
+	return nil
+}
+
+func (x *CooldownState) GetCooldownUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CooldownUntil
+	}
+	return nil
+}
+
+func (x *CooldownState) GetInterventionCounts() map[string]int64 {
+	if x != nil {
+		return x.InterventionCounts
+	}
+	return nil
+}
+
+func (x *CooldownState) GetLastSignalAt() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSignalAt
+	}
+	return nil
+}
+
+func (x *CooldownState) GetOnCooldown() bool {
+	if x != nil {
+		return x.OnCooldown
+	}
+	return false
+}
+
+type ShadowRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	ActionId      string                 `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShadowRecord) Reset() {
+	*x = ShadowRecord{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShadowRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowRecord) ProtoMessage() {}
+
+func (x *ShadowRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowRecord.ProtoReflect.Descriptor instead.
+func (*ShadowRecord) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShadowRecord) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ShadowRecord) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *ShadowRecord) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShadowRecord) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+// SessionTrackingData mirrors service.SessionTrackingData.
+type SessionTrackingData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Login count per ISO year-week (YYYYWW).
+	LoginCount    map[string]int64 `protobuf:"bytes,1,rep,name=login_count,json=loginCount,proto3" json:"login_count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionTrackingData) Reset() {
+	*x = SessionTrackingData{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionTrackingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTrackingData) ProtoMessage() {}
+
+func (x *SessionTrackingData) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTrackingData.ProtoReflect.Descriptor instead.
+func (*SessionTrackingData) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SessionTrackingData) GetLoginCount() map[string]int64 {
+	if x != nil {
+		return x.LoginCount
+	}
+	return nil
+}
+
+type GetPlayerStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerStateRequest) Reset() {
+	*x = GetPlayerStateRequest{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStateRequest) ProtoMessage() {}
+
+func (x *GetPlayerStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStateRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStateRequest) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPlayerStateRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetPlayerStateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPlayerStateResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChurnState      *ChurnState            `protobuf:"bytes,2,opt,name=churn_state,json=churnState,proto3" json:"churn_state,omitempty"`
+	SessionTracking *SessionTrackingData   `protobuf:"bytes,3,opt,name=session_tracking,json=sessionTracking,proto3" json:"session_tracking,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPlayerStateResponse) Reset() {
+	*x = GetPlayerStateResponse{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStateResponse) ProtoMessage() {}
+
+func (x *GetPlayerStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStateResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerStateResponse) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPlayerStateResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPlayerStateResponse) GetChurnState() *ChurnState {
+	if x != nil {
+		return x.ChurnState
+	}
+	return nil
+}
+
+func (x *GetPlayerStateResponse) GetSessionTracking() *SessionTrackingData {
+	if x != nil {
+		return x.SessionTracking
+	}
+	return nil
+}
+
+type ClearCooldownsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCooldownsRequest) Reset() {
+	*x = ClearCooldownsRequest{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCooldownsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCooldownsRequest) ProtoMessage() {}
+
+func (x *ClearCooldownsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCooldownsRequest.ProtoReflect.Descriptor instead.
+func (*ClearCooldownsRequest) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClearCooldownsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ClearCooldownsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ClearCooldownsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rules whose per-player cooldown was cleared.
+	ClearedRuleIds []string    `protobuf:"bytes,1,rep,name=cleared_rule_ids,json=clearedRuleIds,proto3" json:"cleared_rule_ids,omitempty"`
+	ChurnState     *ChurnState `protobuf:"bytes,2,opt,name=churn_state,json=churnState,proto3" json:"churn_state,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClearCooldownsResponse) Reset() {
+	*x = ClearCooldownsResponse{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCooldownsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCooldownsResponse) ProtoMessage() {}
+
+func (x *ClearCooldownsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCooldownsResponse.ProtoReflect.Descriptor instead.
+func (*ClearCooldownsResponse) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClearCooldownsResponse) GetClearedRuleIds() []string {
+	if x != nil {
+		return x.ClearedRuleIds
+	}
+	return nil
+}
+
+func (x *ClearCooldownsResponse) GetChurnState() *ChurnState {
+	if x != nil {
+		return x.ChurnState
+	}
+	return nil
+}
+
+type UpdateInterventionOutcomeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InterventionId string                 `protobuf:"bytes,3,opt,name=intervention_id,json=interventionId,proto3" json:"intervention_id,omitempty"`
+	// Either "completed" or "failed".
+	Outcome       string `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInterventionOutcomeRequest) Reset() {
+	*x = UpdateInterventionOutcomeRequest{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInterventionOutcomeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInterventionOutcomeRequest) ProtoMessage() {}
+
+func (x *UpdateInterventionOutcomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInterventionOutcomeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInterventionOutcomeRequest) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateInterventionOutcomeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateInterventionOutcomeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateInterventionOutcomeRequest) GetInterventionId() string {
+	if x != nil {
+		return x.InterventionId
+	}
+	return ""
+}
+
+func (x *UpdateInterventionOutcomeRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+type UpdateInterventionOutcomeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intervention  *InterventionRecord    `protobuf:"bytes,1,opt,name=intervention,proto3" json:"intervention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInterventionOutcomeResponse) Reset() {
+	*x = UpdateInterventionOutcomeResponse{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInterventionOutcomeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInterventionOutcomeResponse) ProtoMessage() {}
+
+func (x *UpdateInterventionOutcomeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInterventionOutcomeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInterventionOutcomeResponse) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateInterventionOutcomeResponse) GetIntervention() *InterventionRecord {
+	if x != nil {
+		return x.Intervention
+	}
+	return nil
+}
+
+type DeleteChurnStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChurnStateRequest) Reset() {
+	*x = DeleteChurnStateRequest{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChurnStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChurnStateRequest) ProtoMessage() {}
+
+func (x *DeleteChurnStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChurnStateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChurnStateRequest) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteChurnStateRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteChurnStateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReloadPipelineConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadPipelineConfigRequest) Reset() {
+	*x = ReloadPipelineConfigRequest{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadPipelineConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadPipelineConfigRequest) ProtoMessage() {}
+
+func (x *ReloadPipelineConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadPipelineConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadPipelineConfigRequest) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReloadPipelineConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ReloadPipelineConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the pipeline config that ran before the reload.
+	PreviousVersion string `protobuf:"bytes,1,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	// Version of the pipeline config that runs now.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// False if the config file was unchanged.
+	Reloaded      bool `protobuf:"varint,3,opt,name=reloaded,proto3" json:"reloaded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadPipelineConfigResponse) Reset() {
+	*x = ReloadPipelineConfigResponse{}
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadPipelineConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadPipelineConfigResponse) ProtoMessage() {}
+
+func (x *ReloadPipelineConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_churn_intervention_admin_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadPipelineConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadPipelineConfigResponse) Descriptor() ([]byte, []int) {
+	return file_churn_intervention_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReloadPipelineConfigResponse) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+func (x *ReloadPipelineConfigResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ReloadPipelineConfigResponse) GetReloaded() bool {
+	if x != nil {
+		return x.Reloaded
+	}
+	return false
+}
+
+var File_churn_intervention_admin_v1_admin_proto protoreflect.FileDescriptor
+
+const file_churn_intervention_admin_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"'churn-intervention/admin/v1/admin.proto\x12\x0echurn.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x02\n" +
+	"\n" +
+	"ChurnState\x12B\n" +
+	"\x0esignal_history\x18\x01 \x03(\v2\x1b.churn.admin.v1.ChurnSignalR\rsignalHistory\x12U\n" +
+	"\x14intervention_history\x18\x02 \x03(\v2\".churn.admin.v1.InterventionRecordR\x13interventionHistory\x129\n" +
+	"\bcooldown\x18\x03 \x01(\v2\x1d.churn.admin.v1.CooldownStateR\bcooldown\x12C\n" +
+	"\x0eshadow_history\x18\x04 \x03(\v2\x1c.churn.admin.v1.ShadowRecordR\rshadowHistory\x12\x1a\n" +
+	"\brevision\x18\x05 \x01(\x03R\brevision\"\xaf\x01\n" +
+	"\vChurnSignal\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12;\n" +
+	"\vdetected_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xdf\x02\n" +
+	"\x12InterventionRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
+	"\ftriggered_by\x18\x03 \x01(\tR\vtriggeredBy\x12=\n" +
+	"\ftriggered_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vtriggeredAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\aoutcome\x18\x06 \x01(\tR\aoutcome\x129\n" +
+	"\n" +
+	"outcome_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\toutcomeAt\x123\n" +
+	"\bmetadata\x18\b \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xa4\x04\n" +
+	"\rCooldownState\x12L\n" +
+	"\x14last_intervention_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x12lastInterventionAt\x12A\n" +
+	"\x0ecooldown_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rcooldownUntil\x12f\n" +
+	"\x13intervention_counts\x18\x03 \x03(\v25.churn.admin.v1.CooldownState.InterventionCountsEntryR\x12interventionCounts\x12U\n" +
+	"\x0elast_signal_at\x18\x04 \x03(\v2/.churn.admin.v1.CooldownState.LastSignalAtEntryR\flastSignalAt\x12\x1f\n" +
+	"\von_cooldown\x18\x05 \x01(\bR\n" +
+	"onCooldown\x1aE\n" +
+	"\x17InterventionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a[\n" +
+	"\x11LastSignalAtEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05value:\x028\x01\"\xa3\x01\n" +
+	"\fShadowRecord\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\taction_id\x18\x02 \x01(\tR\bactionId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12;\n" +
+	"\vrecorded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"\xaa\x01\n" +
+	"\x13SessionTrackingData\x12T\n" +
+	"\vlogin_count\x18\x01 \x03(\v23.churn.admin.v1.SessionTrackingData.LoginCountEntryR\n" +
+	"loginCount\x1a=\n" +
+	"\x0fLoginCountEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"N\n" +
+	"\x15GetPlayerStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xbe\x01\n" +
+	"\x16GetPlayerStateResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\vchurn_state\x18\x02 \x01(\v2\x1a.churn.admin.v1.ChurnStateR\n" +
+	"churnState\x12N\n" +
+	"\x10session_tracking\x18\x03 \x01(\v2#.churn.admin.v1.SessionTrackingDataR\x0fsessionTracking\"N\n" +
+	"\x15ClearCooldownsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x7f\n" +
+	"\x16ClearCooldownsResponse\x12(\n" +
+	"\x10cleared_rule_ids\x18\x01 \x03(\tR\x0eclearedRuleIds\x12;\n" +
+	"\vchurn_state\x18\x02 \x01(\v2\x1a.churn.admin.v1.ChurnStateR\n" +
+	"churnState\"\x9c\x01\n" +
+	" UpdateInterventionOutcomeRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0fintervention_id\x18\x03 \x01(\tR\x0einterventionId\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\"k\n" +
+	"!UpdateInterventionOutcomeResponse\x12F\n" +
+	"\fintervention\x18\x01 \x01(\v2\".churn.admin.v1.InterventionRecordR\fintervention\"P\n" +
+	"\x17DeleteChurnStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\";\n" +
+	"\x1bReloadPipelineConfigRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x7f\n" +
+	"\x1cReloadPipelineConfigResponse\x12)\n" +
+	"\x10previous_version\x18\x01 \x01(\tR\x0fpreviousVersion\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
+	"\breloaded\x18\x03 \x01(\bR\breloaded2\xa8\a\n" +
+	"\x11ChurnAdminService\x12\xa5\x01\n" +
+	"\x0eGetPlayerState\x12%.churn.admin.v1.GetPlayerStateRequest\x1a&.churn.admin.v1.GetPlayerStateResponse\"D\x82\xd3\xe4\x93\x02>\x12</churn/v1/admin/namespaces/{namespace}/users/{user_id}/state\x12\xa9\x01\n" +
+	"\x0eClearCooldowns\x12%.churn.admin.v1.ClearCooldownsRequest\x1a&.churn.admin.v1.ClearCooldownsResponse\"H\x82\xd3\xe4\x93\x02B*@/churn/v1/admin/namespaces/{namespace}/users/{user_id}/cooldowns\x12\xeb\x01\n" +
+	"\x19UpdateInterventionOutcome\x120.churn.admin.v1.UpdateInterventionOutcomeRequest\x1a1.churn.admin.v1.UpdateInterventionOutcomeResponse\"i\x82\xd3\xe4\x93\x02c:\x01*\x1a^/churn/v1/admin/namespaces/{namespace}/users/{user_id}/interventions/{intervention_id}/outcome\x12\x99\x01\n" +
+	"\x10DeleteChurnState\x12'.churn.admin.v1.DeleteChurnStateRequest\x1a\x16.google.protobuf.Empty\"D\x82\xd3\xe4\x93\x02>*</churn/v1/admin/namespaces/{namespace}/users/{user_id}/state\x12\xb4\x01\n" +
+	"\x14ReloadPipelineConfig\x12+.churn.admin.v1.ReloadPipelineConfigRequest\x1a,.churn.admin.v1.ReloadPipelineConfigResponse\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/churn/v1/admin/namespaces/{namespace}/pipeline/reloadBYZWgithub.com/AccelByte/extend-churn-intervention/pkg/pb/churn-intervention/admin/v1;adminb\x06proto3"
+
+var (
+	file_churn_intervention_admin_v1_admin_proto_rawDescOnce sync.Once
+	file_churn_intervention_admin_v1_admin_proto_rawDescData []byte
+)
+
+func file_churn_intervention_admin_v1_admin_proto_rawDescGZIP() []byte {
+	file_churn_intervention_admin_v1_admin_proto_rawDescOnce.Do(func() {
+		file_churn_intervention_admin_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_churn_intervention_admin_v1_admin_proto_rawDesc), len(file_churn_intervention_admin_v1_admin_proto_rawDesc)))
+	})
+	return file_churn_intervention_admin_v1_admin_proto_rawDescData
+}
+
+var file_churn_intervention_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_churn_intervention_admin_v1_admin_proto_goTypes = []any{
+	(*ChurnState)(nil),                        // 0: churn.admin.v1.ChurnState
+	(*ChurnSignal)(nil),                       // 1: churn.admin.v1.ChurnSignal
+	(*InterventionRecord)(nil),                // 2: churn.admin.v1.InterventionRecord
+	(*CooldownState)(nil),                     // 3: churn.admin.v1.CooldownState
+	(*ShadowRecord)(nil),                      // 4: churn.admin.v1.ShadowRecord
+	(*SessionTrackingData)(nil),               // 5: churn.admin.v1.SessionTrackingData
+	(*GetPlayerStateRequest)(nil),             // 6: churn.admin.v1.GetPlayerStateRequest
+	(*GetPlayerStateResponse)(nil),            // 7: churn.admin.v1.GetPlayerStateResponse
+	(*ClearCooldownsRequest)(nil),             // 8: churn.admin.v1.ClearCooldownsRequest
+	(*ClearCooldownsResponse)(nil),            // 9: churn.admin.v1.ClearCooldownsResponse
+	(*UpdateInterventionOutcomeRequest)(nil),  // 10: churn.admin.v1.UpdateInterventionOutcomeRequest
+	(*UpdateInterventionOutcomeResponse)(nil), // 11: churn.admin.v1.UpdateInterventionOutcomeResponse
+	(*DeleteChurnStateRequest)(nil),           // 12: churn.admin.v1.DeleteChurnStateRequest
+	(*ReloadPipelineConfigRequest)(nil),       // 13: churn.admin.v1.ReloadPipelineConfigRequest
+	(*ReloadPipelineConfigResponse)(nil),      // 14: churn.admin.v1.ReloadPipelineConfigResponse
+	nil,                                       // 15: churn.admin.v1.CooldownState.InterventionCountsEntry
+	nil,                                       // 16: churn.admin.v1.CooldownState.LastSignalAtEntry
+	nil,                                       // 17: churn.admin.v1.SessionTrackingData.LoginCountEntry
+	(*timestamppb.Timestamp)(nil),             // 18: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                   // 19: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                     // 20: google.protobuf.Empty
+}
+var file_churn_intervention_admin_v1_admin_proto_depIdxs = []int32{
+	1,  // 0: churn.admin.v1.ChurnState.signal_history:type_name -> churn.admin.v1.ChurnSignal
+	2,  // 1: churn.admin.v1.ChurnState.intervention_history:type_name -> churn.admin.v1.InterventionRecord
+	3,  // 2: churn.admin.v1.ChurnState.cooldown:type_name -> churn.admin.v1.CooldownState
+	4,  // 3: churn.admin.v1.ChurnState.shadow_history:type_name -> churn.admin.v1.ShadowRecord
+	18, // 4: churn.admin.v1.ChurnSignal.detected_at:type_name -> google.protobuf.Timestamp
+	19, // 5: churn.admin.v1.ChurnSignal.metadata:type_name -> google.protobuf.Struct
+	18, // 6: churn.admin.v1.InterventionRecord.triggered_at:type_name -> google.protobuf.Timestamp
+	18, // 7: churn.admin.v1.InterventionRecord.expires_at:type_name -> google.protobuf.Timestamp
+	18, // 8: churn.admin.v1.InterventionRecord.outcome_at:type_name -> google.protobuf.Timestamp
+	19, // 9: churn.admin.v1.InterventionRecord.metadata:type_name -> google.protobuf.Struct
+	18, // 10: churn.admin.v1.CooldownState.last_intervention_at:type_name -> google.protobuf.Timestamp
+	18, // 11: churn.admin.v1.CooldownState.cooldown_until:type_name -> google.protobuf.Timestamp
+	15, // 12: churn.admin.v1.CooldownState.intervention_counts:type_name -> churn.admin.v1.CooldownState.InterventionCountsEntry
+	16, // 13: churn.admin.v1.CooldownState.last_signal_at:type_name -> churn.admin.v1.CooldownState.LastSignalAtEntry
+	18, // 14: churn.admin.v1.ShadowRecord.recorded_at:type_name -> google.protobuf.Timestamp
+	17, // 15: churn.admin.v1.SessionTrackingData.login_count:type_name -> churn.admin.v1.SessionTrackingData.LoginCountEntry
+	0,  // 16: churn.admin.v1.GetPlayerStateResponse.churn_state:type_name -> churn.admin.v1.ChurnState
+	5,  // 17: churn.admin.v1.GetPlayerStateResponse.session_tracking:type_name -> churn.admin.v1.SessionTrackingData
+	0,  // 18: churn.admin.v1.ClearCooldownsResponse.churn_state:type_name -> churn.admin.v1.ChurnState
+	2,  // 19: churn.admin.v1.UpdateInterventionOutcomeResponse.intervention:type_name -> churn.admin.v1.InterventionRecord
+	18, // 20: churn.admin.v1.CooldownState.LastSignalAtEntry.value:type_name -> google.protobuf.Timestamp
+	6,  // 21: churn.admin.v1.ChurnAdminService.GetPlayerState:input_type -> churn.admin.v1.GetPlayerStateRequest
+	8,  // 22: churn.admin.v1.ChurnAdminService.ClearCooldowns:input_type -> churn.admin.v1.ClearCooldownsRequest
+	10, // 23: churn.admin.v1.ChurnAdminService.UpdateInterventionOutcome:input_type -> churn.admin.v1.UpdateInterventionOutcomeRequest
+	12, // 24: churn.admin.v1.ChurnAdminService.DeleteChurnState:input_type -> churn.admin.v1.DeleteChurnStateRequest
+	13, // 25: churn.admin.v1.ChurnAdminService.ReloadPipelineConfig:input_type -> churn.admin.v1.ReloadPipelineConfigRequest
+	7,  // 26: churn.admin.v1.ChurnAdminService.GetPlayerState:output_type -> churn.admin.v1.GetPlayerStateResponse
+	9,  // 27: churn.admin.v1.ChurnAdminService.ClearCooldowns:output_type -> churn.admin.v1.ClearCooldownsResponse
+	11, // 28: churn.admin.v1.ChurnAdminService.UpdateInterventionOutcome:output_type -> churn.admin.v1.UpdateInterventionOutcomeResponse
+	20, // 29: churn.admin.v1.ChurnAdminService.DeleteChurnState:output_type -> google.protobuf.Empty
+	14, // 30: churn.admin.v1.ChurnAdminService.ReloadPipelineConfig:output_type -> churn.admin.v1.ReloadPipelineConfigResponse
+	26, // [26:31] is the sub-list for method output_type
+	21, // [21:26] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_churn_intervention_admin_v1_admin_proto_init() }
+func file_churn_intervention_admin_v1_admin_proto_init() {
+	if File_churn_intervention_admin_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_churn_intervention_admin_v1_admin_proto_rawDesc), len(file_churn_intervention_admin_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_churn_intervention_admin_v1_admin_proto_goTypes,
+		DependencyIndexes: file_churn_intervention_admin_v1_admin_proto_depIdxs,
+		MessageInfos:      file_churn_intervention_admin_v1_admin_proto_msgTypes,
+	}.Build()
+	File_churn_intervention_admin_v1_admin_proto = out.File
+	file_churn_intervention_admin_v1_admin_proto_goTypes = nil
+	file_churn_intervention_admin_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: churn-intervention/admin/v1/admin.proto
+
+package admin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ChurnAdminService_GetPlayerState_FullMethodName            = "/churn.admin.v1.ChurnAdminService/GetPlayerState"
+	ChurnAdminService_ClearCooldowns_FullMethodName            = "/churn.admin.v1.ChurnAdminService/ClearCooldowns"
+	ChurnAdminService_UpdateInterventionOutcome_FullMethodName = "/churn.admin.v1.ChurnAdminService/UpdateInterventionOutcome"
+	ChurnAdminService_DeleteChurnState_FullMethodName          = "/churn.admin.v1.ChurnAdminService/DeleteChurnState"
+	ChurnAdminService_ReloadPipelineConfig_FullMethodName      = "/churn.admin.v1.ChurnAdminService/ReloadPipelineConfig"
+)
+
+// ChurnAdminServiceClient is the client API for ChurnAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ChurnAdminService lets support staff inspect and manage a player's churn state.
+type ChurnAdminServiceClient interface {
+	// GetPlayerState returns the player's churn state and login session tracking data.
+	GetPlayerState(ctx context.Context, in *GetPlayerStateRequest, opts ...grpc.CallOption) (*GetPlayerStateResponse, error)
+	// ClearCooldowns ends the player's intervention cooldown and per-player rule cooldowns.
+	ClearCooldowns(ctx context.Context, in *ClearCooldownsRequest, opts ...grpc.CallOption) (*ClearCooldownsResponse, error)
+	// UpdateInterventionOutcome marks one of the player's interventions completed or failed.
+	UpdateInterventionOutcome(ctx context.Context, in *UpdateInterventionOutcomeRequest, opts ...grpc.CallOption) (*UpdateInterventionOutcomeResponse, error)
+	// DeleteChurnState deletes the player's churn state.
+	DeleteChurnState(ctx context.Context, in *DeleteChurnStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReloadPipelineConfig reloads the pipeline config of the namespace from its file.
+	// The running config is kept if the file does not load.
+	ReloadPipelineConfig(ctx context.Context, in *ReloadPipelineConfigRequest, opts ...grpc.CallOption) (*ReloadPipelineConfigResponse, error)
+}
+
+type churnAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChurnAdminServiceClient(cc grpc.ClientConnInterface) ChurnAdminServiceClient {
+	return &churnAdminServiceClient{cc}
+}
+
+func (c *churnAdminServiceClient) GetPlayerState(ctx context.Context, in *GetPlayerStateRequest, opts ...grpc.CallOption) (*GetPlayerStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayerStateResponse)
+	err := c.cc.Invoke(ctx, ChurnAdminService_GetPlayerState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *churnAdminServiceClient) ClearCooldowns(ctx context.Context, in *ClearCooldownsRequest, opts ...grpc.CallOption) (*ClearCooldownsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearCooldownsResponse)
+	err := c.cc.Invoke(ctx, ChurnAdminService_ClearCooldowns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *churnAdminServiceClient) UpdateInterventionOutcome(ctx context.Context, in *UpdateInterventionOutcomeRequest, opts ...grpc.CallOption) (*UpdateInterventionOutcomeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInterventionOutcomeResponse)
+	err := c.cc.Invoke(ctx, ChurnAdminService_UpdateInterventionOutcome_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *churnAdminServiceClient) DeleteChurnState(ctx context.Context, in *DeleteChurnStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChurnAdminService_DeleteChurnState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *churnAdminServiceClient) ReloadPipelineConfig(ctx context.Context, in *ReloadPipelineConfigRequest, opts ...grpc.CallOption) (*ReloadPipelineConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadPipelineConfigResponse)
+	err := c.cc.Invoke(ctx, ChurnAdminService_ReloadPipelineConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChurnAdminServiceServer is the server API for ChurnAdminService service.
+// All implementations should embed UnimplementedChurnAdminServiceServer
+// for forward compatibility.
+//
+// ChurnAdminService lets support staff inspect and manage a player's churn state.
+type ChurnAdminServiceServer interface {
+	// GetPlayerState returns the player's churn state and login session tracking data.
+	GetPlayerState(context.Context, *GetPlayerStateRequest) (*GetPlayerStateResponse, error)
+	// ClearCooldowns ends the player's intervention cooldown and per-player rule cooldowns.
+	ClearCooldowns(context.Context, *ClearCooldownsRequest) (*ClearCooldownsResponse, error)
+	// UpdateInterventionOutcome marks one of the player's interventions completed or failed.
+	UpdateInterventionOutcome(context.Context, *UpdateInterventionOutcomeRequest) (*UpdateInterventionOutcomeResponse, error)
+	// DeleteChurnState deletes the player's churn state.
+	DeleteChurnState(context.Context, *DeleteChurnStateRequest) (*emptypb.Empty, error)
+	// ReloadPipelineConfig reloads the pipeline config of the namespace from its file.
+	// The running config is kept if the file does not load.
+	ReloadPipelineConfig(context.Context, *ReloadPipelineConfigRequest) (*ReloadPipelineConfigResponse, error)
+}
+
+// UnimplementedChurnAdminServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChurnAdminServiceServer struct{}
+
+func (UnimplementedChurnAdminServiceServer) GetPlayerState(context.Context, *GetPlayerStateRequest) (*GetPlayerStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerState not implemented")
+}
+func (UnimplementedChurnAdminServiceServer) ClearCooldowns(context.Context, *ClearCooldownsRequest) (*ClearCooldownsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCooldowns not implemented")
+}
+func (UnimplementedChurnAdminServiceServer) UpdateInterventionOutcome(context.Context, *UpdateInterventionOutcomeRequest) (*UpdateInterventionOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterventionOutcome not implemented")
+}
+func (UnimplementedChurnAdminServiceServer) DeleteChurnState(context.Context, *DeleteChurnStateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChurnState not implemented")
+}
+func (UnimplementedChurnAdminServiceServer) ReloadPipelineConfig(context.Context, *ReloadPipelineConfigRequest) (*ReloadPipelineConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadPipelineConfig not implemented")
+}
+func (UnimplementedChurnAdminServiceServer) testEmbeddedByValue() {}
+
+// UnsafeChurnAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChurnAdminServiceServer will
+// result in compilation errors.
+type UnsafeChurnAdminServiceServer interface {
+	mustEmbedUnimplementedChurnAdminServiceServer()
+}
+
+func RegisterChurnAdminServiceServer(s grpc.ServiceRegistrar, srv ChurnAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChurnAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChurnAdminService_ServiceDesc, srv)
+}
+
+func _ChurnAdminService_GetPlayerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChurnAdminServiceServer).GetPlayerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChurnAdminService_GetPlayerState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChurnAdminServiceServer).GetPlayerState(ctx, req.(*GetPlayerStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChurnAdminService_ClearCooldowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCooldownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChurnAdminServiceServer).ClearCooldowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChurnAdminService_ClearCooldowns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChurnAdminServiceServer).ClearCooldowns(ctx, req.(*ClearCooldownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChurnAdminService_UpdateInterventionOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterventionOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChurnAdminServiceServer).UpdateInterventionOutcome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChurnAdminService_UpdateInterventionOutcome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChurnAdminServiceServer).UpdateInterventionOutcome(ctx, req.(*UpdateInterventionOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChurnAdminService_DeleteChurnState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChurnStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChurnAdminServiceServer).DeleteChurnState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChurnAdminService_DeleteChurnState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChurnAdminServiceServer).DeleteChurnState(ctx, req.(*DeleteChurnStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChurnAdminService_ReloadPipelineConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadPipelineConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChurnAdminServiceServer).ReloadPipelineConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChurnAdminService_ReloadPipelineConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChurnAdminServiceServer).ReloadPipelineConfig(ctx, req.(*ReloadPipelineConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChurnAdminService_ServiceDesc is the grpc.ServiceDesc for ChurnAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChurnAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "churn.admin.v1.ChurnAdminService",
+	HandlerType: (*ChurnAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlayerState",
+			Handler:    _ChurnAdminService_GetPlayerState_Handler,
+		},
+		{
+			MethodName: "ClearCooldowns",
+			Handler:    _ChurnAdminService_ClearCooldowns_Handler,
+		},
+		{
+			MethodName: "UpdateInterventionOutcome",
+			Handler:    _ChurnAdminService_UpdateInterventionOutcome_Handler,
+		},
+		{
+			MethodName: "DeleteChurnState",
+			Handler:    _ChurnAdminService_DeleteChurnState_Handler,
+		},
+		{
+			MethodName: "ReloadPipelineConfig",
+			Handler:    _ChurnAdminService_ReloadPipelineConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "churn-intervention/admin/v1/admin.proto",
+}
@@ -0,0 +1,89 @@
+package pipeline
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/audit"
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+)
+
+// SetAuditSink enables the decision audit log.
+// When set, every processed signal is recorded with the decision taken for each rule
+// evaluated and the result of each action executed.
+func (m *Manager) SetAuditSink(sink audit.Sink) {
+	m.auditSink = sink
+}
+
+// newAuditRecord starts the audit record of a signal, or returns nil when auditing is disabled.
+func (m *Manager) newAuditRecord(ctx context.Context, eventType, eventID string, sig signal.Signal, decisions []rule.Decision) *audit.Record {
+	if m.auditSink == nil {
+		return nil
+	}
+
+	record := &audit.Record{
+		Timestamp:  m.clock.Now().UTC(),
+		EventType:  eventType,
+		EventID:    eventID,
+		Namespace:  namespace.FromContext(ctx),
+		UserID:     sig.UserID(),
+		SignalType: sig.Type(),
+		Rules:      make([]audit.RuleDecision, 0, len(decisions)),
+	}
+	for _, decision := range decisions {
+		record.Rules = append(record.Rules, audit.RuleDecision{
+			RuleID:  decision.RuleID,
+			Outcome: decision.Outcome,
+			Reason:  decision.Reason,
+		})
+	}
+	return record
+}
+
+// addAuditActions adds the action results of a trigger to the audit record.
+func addAuditActions(record *audit.Record, ruleID string, results []*action.ActionResult) {
+	if record == nil {
+		return
+	}
+
+	for _, result := range results {
+		decision := audit.ActionDecision{
+			RuleID:   ruleID,
+			ActionID: result.ActionID,
+			Status:   audit.ActionStatusSucceeded,
+		}
+		decision.Attempts, _ = result.Metadata["attempts"].(int)
+
+		if result.Error != nil {
+			decision.Status = audit.ActionStatusFailed
+			decision.Error = result.Error.Error()
+		} else if shadow, _ := result.Metadata["shadow"].(bool); shadow {
+			decision.Status = audit.ActionStatusShadow
+			decision.Would, _ = result.Metadata["would"].(string)
+		} else if async, _ := result.Metadata["async"].(bool); async {
+			decision.Status = audit.ActionStatusQueued
+		}
+
+		record.Actions = append(record.Actions, decision)
+	}
+}
+
+// writeAuditRecord writes the audit record to the sink.
+// Failing to write is logged but does not fail the event, as its actions already ran.
+func (m *Manager) writeAuditRecord(ctx context.Context, record *audit.Record) {
+	if record == nil {
+		return
+	}
+
+	if err := m.auditSink.Write(ctx, record); err != nil {
+		metrics.AuditWriteFailuresTotal.Inc()
+		m.logger.Error("failed to write decision audit record",
+			slog.String("event_id", record.EventID),
+			slog.String("user_id", record.UserID),
+			slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,83 @@
+package pipeline_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/audit"
+	asyncapi_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+)
+
+// memoryAuditSink collects audit records for testing
+type memoryAuditSink struct {
+	mu      sync.Mutex
+	records []*audit.Record
+}
+
+func (s *memoryAuditSink) Write(ctx context.Context, record *audit.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, record)
+	return nil
+}
+
+func TestProcessOAuthEvent_AuditRecord(t *testing.T) {
+	processor := setupTestProcessor(&mockStateStore{state: &service.ChurnState{}})
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "matching-rule", shouldMatch: true})
+	ruleRegistry.Register(&mockRule{id: "other-rule", shouldMatch: false})
+	engine := rule.NewEngine(ruleRegistry)
+
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(&mockAction{id: "ok-action"})
+	actionRegistry.Register(&mockAction{id: "failing-action", shouldFail: true})
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor, map[string][]string{
+		"matching-rule": {"ok-action", "failing-action"},
+	}, nil)
+	sink := &memoryAuditSink{}
+	manager.SetAuditSink(sink)
+
+	event := &asyncapi_iam.OauthTokenGenerated{
+		Id:        "event-1",
+		UserId:    "test-user",
+		Namespace: "test",
+	}
+	if err := manager.ProcessOAuthEvent(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if len(sink.records) != 1 {
+		t.Fatalf("expected 1 audit record, got %d", len(sink.records))
+	}
+	record := sink.records[0]
+
+	if record.EventID != "event-1" || record.UserID != "test-user" || record.SignalType != "login" {
+		t.Errorf("unexpected record header: %+v", record)
+	}
+
+	outcomes := make(map[string]string)
+	for _, decision := range record.Rules {
+		outcomes[decision.RuleID] = decision.Outcome
+	}
+	if outcomes["matching-rule"] != rule.DecisionTriggered || outcomes["other-rule"] != rule.DecisionNotMatched {
+		t.Errorf("unexpected rule decisions: %+v", record.Rules)
+	}
+
+	if len(record.Actions) != 2 {
+		t.Fatalf("expected 2 action decisions, got %+v", record.Actions)
+	}
+	if record.Actions[0].ActionID != "ok-action" || record.Actions[0].Status != audit.ActionStatusSucceeded {
+		t.Errorf("expected ok-action to succeed, got %+v", record.Actions[0])
+	}
+	if record.Actions[1].ActionID != "failing-action" || record.Actions[1].Status != audit.ActionStatusFailed || record.Actions[1].Error == "" {
+		t.Errorf("expected failing-action to fail with an error, got %+v", record.Actions[1])
+	}
+}
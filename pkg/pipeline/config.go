@@ -1,17 +1,36 @@
 package pipeline
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	rulepkg "github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	"gopkg.in/yaml.v3"
 )
 
 // Config represents the complete pipeline configuration.
 type Config struct {
+	Signals []SignalConfig `yaml:"signals,omitempty"`
 	Rules   []RuleConfig   `yaml:"rules"`
 	Actions []ActionConfig `yaml:"actions"`
+
+	// Version identifies the configuration file contents (see ConfigVersion).
+	// It is set by LoadConfig and is empty for configurations built in code.
+	Version string `yaml:"-"`
+}
+
+// SignalConfig maps updates of a stat code to signals without a dedicated
+// event processor (see signal.StatMapping).
+type SignalConfig struct {
+	StatCode string   `yaml:"stat_code"`
+	Type     string   `yaml:"type"`               // Signal type rules match on
+	Value    string   `yaml:"value,omitempty"`    // "latest_value" (default) or "inc"
+	Metadata []string `yaml:"metadata,omitempty"` // additional_data fields copied into the signal metadata
 }
 
 // RuleConfig represents a rule configuration entry.
@@ -19,18 +38,36 @@ type RuleConfig struct {
 	ID         string                 `yaml:"id"`
 	Type       string                 `yaml:"type"`
 	Enabled    bool                   `yaml:"enabled"`
-	Actions    []string               `yaml:"actions,omitempty"` // Action IDs to execute when rule triggers
+	Mode       string                 `yaml:"mode,omitempty"`       // "live" (default) or "shadow"
+	Actions    []string               `yaml:"actions,omitempty"`    // Action IDs to execute when rule triggers
+	Cooldown   *CooldownConfig        `yaml:"cooldown,omitempty"`   // Minimum time between triggers
+	Conditions map[string]interface{} `yaml:"conditions,omitempty"` // Named CEL expressions that must all hold
 	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
 }
 
+// CooldownConfig represents the trigger cooldown of a rule entry, e.g. duration "24h"
+// and scope "per_user" (default) or "global". It is the rule package's cooldown, so that
+// both validate cooldowns the same way.
+type CooldownConfig = rulepkg.CooldownConfig
+
 // ActionConfig represents an action configuration entry.
 type ActionConfig struct {
 	ID         string                 `yaml:"id"`
 	Type       string                 `yaml:"type"`
 	Enabled    bool                   `yaml:"enabled"`
+	Mode       string                 `yaml:"mode,omitempty"`  // "live" (default) or "shadow"
+	Async      bool                   `yaml:"async,omitempty"` // Execute off the request path
+	Retry      *RetryConfig           `yaml:"retry,omitempty"` // Retry policy for transient failures
 	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
 }
 
+// RetryConfig represents the retry policy of an action entry.
+type RetryConfig struct {
+	MaxAttempts int           `yaml:"max_attempts"` // Total attempts, including the first
+	Delay       time.Duration `yaml:"delay"`        // e.g., "500ms", "2s"
+	Backoff     string        `yaml:"backoff"`      // "constant" (default), "linear", "exponential"
+}
+
 // LoadConfig loads pipeline configuration from a YAML file.
 // Supports environment variable expansion in the form ${VAR_NAME} or ${VAR_NAME:default}.
 func LoadConfig(path string) (*Config, error) {
@@ -40,12 +77,14 @@ func LoadConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
 	}
 
-	// Expand environment variables
-	expanded := expandEnvVars(string(data))
+	return ParseConfig(data)
+}
 
-	// Parse YAML
-	var config Config
-	if err := yaml.Unmarshal([]byte(expanded), &config); err != nil {
+// ParseConfig parses and validates pipeline configuration from YAML file contents.
+// Environment variables are expanded as in LoadConfig.
+func ParseConfig(data []byte) (*Config, error) {
+	config, _, err := decodeConfig(data)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse YAML config: %w", err)
 	}
 
@@ -54,52 +93,271 @@ func LoadConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	return &config, nil
+	config.Version = ConfigVersion(data)
+
+	return config, nil
+}
+
+// decodeConfig expands environment variables in configuration file contents and decodes them.
+// It also returns the YAML document node, or nil for an empty document, so that entries can be
+// located in the file. On a *yaml.TypeError the configuration is decoded except for the fields
+// in error, as by yaml.Unmarshal.
+func decodeConfig(data []byte) (*Config, *yaml.Node, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(expandEnvVars(string(data))), &root); err != nil {
+		return nil, nil, err
+	}
+
+	var config Config
+	if len(root.Content) == 0 {
+		return &config, nil, nil
+	}
+
+	doc := root.Content[0]
+	return &config, doc, doc.Decode(&config)
+}
+
+// ConfigVersion returns a short content hash of a configuration file.
+// Identical files always have the same version, so it can be used to detect changes.
+func ConfigVersion(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// ConfigStatus is the status of the pipeline configuration of a namespace.
+type ConfigStatus struct {
+	// Namespace is the namespace of a namespace configuration, "" for the default configuration.
+	Namespace string `json:"namespace"`
+	// Version is the version of the running configuration.
+	Version string `json:"version"`
+	// ReloadError is why the configuration file fails to load, while it does. The pipeline
+	// then keeps running Version rather than the configuration deployed.
+	ReloadError string `json:"reload_error,omitempty"`
 }
 
 // Validate validates the configuration for common errors.
+// It returns the first error of validateEntries.
 func (c *Config) Validate() error {
+	if errs := c.validateEntries(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// validateEntries validates every entry of the configuration and returns all errors found,
+// signals first, then rules, actions and the action references of rules.
+// An entry has at most one error besides its action references.
+func (c *Config) validateEntries() []*entryError {
+	var errs []*entryError
+	fail := func(section string, index int, err error) {
+		errs = append(errs, &entryError{Section: section, Index: index, Err: err})
+	}
+
+	// Check for duplicate signal stat codes
+	statCodes := make(map[string]bool)
+	for i, sig := range c.Signals {
+		if sig.StatCode == "" {
+			fail("signals", i, fmt.Errorf("signal with empty stat code found"))
+			continue
+		}
+		if statCodes[sig.StatCode] {
+			fail("signals", i, fmt.Errorf("duplicate signal stat code: %s", sig.StatCode))
+			continue
+		}
+		statCodes[sig.StatCode] = true
+
+		if err := sig.Validate(); err != nil {
+			fail("signals", i, fmt.Errorf("signal %s %w", sig.StatCode, err))
+		}
+	}
+
 	// Check for duplicate rule IDs
 	ruleIDs := make(map[string]bool)
-	for _, rule := range c.Rules {
+	for i, rule := range c.Rules {
 		if rule.ID == "" {
-			return fmt.Errorf("rule with empty ID found")
+			fail("rules", i, fmt.Errorf("rule with empty ID found"))
+			continue
 		}
 		if ruleIDs[rule.ID] {
-			return fmt.Errorf("duplicate rule ID: %s", rule.ID)
+			fail("rules", i, fmt.Errorf("duplicate rule ID: %s", rule.ID))
+			continue
 		}
 		ruleIDs[rule.ID] = true
 
-		if rule.Type == "" {
-			return fmt.Errorf("rule %s has empty type", rule.ID)
+		if err := rule.Validate(); err != nil {
+			fail("rules", i, fmt.Errorf("rule %s %w", rule.ID, err))
 		}
 	}
 
 	// Check for duplicate action IDs
 	actionIDs := make(map[string]bool)
-	for _, action := range c.Actions {
+	for i, action := range c.Actions {
 		if action.ID == "" {
-			return fmt.Errorf("action with empty ID found")
+			fail("actions", i, fmt.Errorf("action with empty ID found"))
+			continue
 		}
 		if actionIDs[action.ID] {
-			return fmt.Errorf("duplicate action ID: %s", action.ID)
+			fail("actions", i, fmt.Errorf("duplicate action ID: %s", action.ID))
+			continue
 		}
 		actionIDs[action.ID] = true
 
-		if action.Type == "" {
-			return fmt.Errorf("action %s has empty type", action.ID)
+		if err := action.Validate(); err != nil {
+			fail("actions", i, fmt.Errorf("action %s %w", action.ID, err))
 		}
 	}
 
 	// Validate that all action references in rules exist
-	for _, rule := range c.Rules {
+	for i, rule := range c.Rules {
 		for _, actionID := range rule.Actions {
 			if !actionIDs[actionID] {
-				return fmt.Errorf("rule %s references unknown action: %s", rule.ID, actionID)
+				fail("rules", i, &FieldError{Field: "actions", Err: fmt.Errorf("rule %s references unknown action: %s", rule.ID, actionID)})
 			}
 		}
 	}
 
+	return errs
+}
+
+// entryError is a validation error of one signal, rule or action entry of a configuration.
+type entryError struct {
+	Section string // YAML key of the section of the entry: "signals", "rules" or "actions"
+	Index   int    // Position of the entry in its section
+	Err     error
+}
+
+func (e *entryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *entryError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError is a validation error of one field of a rule or action entry.
+type FieldError struct {
+	Field string // YAML key of the field, e.g. "cooldown"
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks the fields of a single signal entry.
+// Errors are FieldErrors that read as a predicate of the signal, e.g. "has empty type".
+func (s SignalConfig) Validate() error {
+	if s.Type == "" {
+		return &FieldError{Field: "type", Err: fmt.Errorf("has empty type")}
+	}
+
+	switch s.Value {
+	case "", signal.StatValueLatest, signal.StatValueInc:
+	default:
+		return &FieldError{Field: "value", Err: fmt.Errorf("has invalid value %q (must be %q or %q)",
+			s.Value, signal.StatValueLatest, signal.StatValueInc)}
+	}
+
+	for _, field := range s.Metadata {
+		if field == "" {
+			return &FieldError{Field: "metadata", Err: fmt.Errorf("has empty metadata field")}
+		}
+		if signal.IsReservedMetadataKey(field) {
+			return &FieldError{Field: "metadata", Err: fmt.Errorf("has reserved metadata field %q", field)}
+		}
+	}
+
+	return nil
+}
+
+// StatMapping returns the stat mapping the signal entry declares.
+func (s SignalConfig) StatMapping() signal.StatMapping {
+	value := s.Value
+	if value == "" {
+		value = signal.StatValueLatest
+	}
+	return signal.StatMapping{
+		StatCode:   s.StatCode,
+		SignalType: s.Type,
+		Value:      value,
+		Metadata:   s.Metadata,
+	}
+}
+
+// Validate checks the fields of a single rule entry.
+// Errors are FieldErrors that read as a predicate of the rule, e.g. "has empty type".
+func (r RuleConfig) Validate() error {
+	if r.Type == "" {
+		return &FieldError{Field: "type", Err: fmt.Errorf("has empty type")}
+	}
+
+	if err := validateMode(r.Mode); err != nil {
+		return &FieldError{Field: "mode", Err: fmt.Errorf("has invalid mode: %w", err)}
+	}
+
+	if err := r.Cooldown.Validate(); err != nil {
+		return &FieldError{Field: "cooldown", Err: fmt.Errorf("has invalid cooldown config: %w", err)}
+	}
+
+	if _, err := rulepkg.CompileConditions(r.Conditions); err != nil {
+		return &FieldError{Field: "conditions", Err: fmt.Errorf("has invalid conditions: %w", err)}
+	}
+
+	return nil
+}
+
+// Validate checks the fields of a single action entry.
+// Errors are FieldErrors that read as a predicate of the action, e.g. "has empty type".
+func (a ActionConfig) Validate() error {
+	if a.Type == "" {
+		return &FieldError{Field: "type", Err: fmt.Errorf("has empty type")}
+	}
+
+	if err := validateMode(a.Mode); err != nil {
+		return &FieldError{Field: "mode", Err: fmt.Errorf("has invalid mode: %w", err)}
+	}
+
+	if err := a.Retry.validate(); err != nil {
+		return &FieldError{Field: "retry", Err: fmt.Errorf("has invalid retry config: %w", err)}
+	}
+
+	return nil
+}
+
+// validateMode checks the mode of a rule or action entry. An empty mode means live.
+func validateMode(mode string) error {
+	switch mode {
+	case "", rulepkg.ModeLive, rulepkg.ModeShadow:
+		return nil
+	default:
+		return fmt.Errorf("unknown mode %q (expected %s or %s)", mode, rulepkg.ModeLive, rulepkg.ModeShadow)
+	}
+}
+
+// validate checks the retry policy. A nil policy is valid and disables retries.
+func (r *RetryConfig) validate() error {
+	if r == nil {
+		return nil
+	}
+
+	if r.MaxAttempts < 1 {
+		return fmt.Errorf("max_attempts must be at least 1, got %d", r.MaxAttempts)
+	}
+
+	if r.Delay < 0 {
+		return fmt.Errorf("delay must not be negative, got %v", r.Delay)
+	}
+
+	switch r.Backoff {
+	case "", "constant", "linear", "exponential":
+	default:
+		return fmt.Errorf("unknown backoff %q (expected constant, linear or exponential)", r.Backoff)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -206,3 +207,220 @@ func TestValidate_EmptyRuleType(t *testing.T) {
 		t.Error("expected validation error for empty rule type")
 	}
 }
+
+func TestLoadConfig_ActionRetry(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "pipeline.yaml")
+
+	configContent := `
+actions:
+  - id: grant-item
+    type: grant_item
+    enabled: true
+    retry:
+      max_attempts: 3
+      delay: 500ms
+      backoff: exponential
+    parameters:
+      item_id: "COMEBACK_REWARD"
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	retry := config.Actions[0].Retry
+	if retry == nil {
+		t.Fatal("expected retry config to be loaded")
+	}
+
+	if retry.MaxAttempts != 3 || retry.Delay != 500*time.Millisecond || retry.Backoff != "exponential" {
+		t.Errorf("unexpected retry config: %+v", retry)
+	}
+}
+
+func TestValidate_InvalidRetry(t *testing.T) {
+	tests := []struct {
+		name  string
+		retry *RetryConfig
+	}{
+		{"zero attempts", &RetryConfig{MaxAttempts: 0}},
+		{"negative delay", &RetryConfig{MaxAttempts: 2, Delay: -time.Second}},
+		{"unknown backoff", &RetryConfig{MaxAttempts: 2, Backoff: "fibonacci"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				Actions: []ActionConfig{
+					{ID: "grant-item", Type: "grant_item", Enabled: true, Retry: tt.retry},
+				},
+			}
+
+			if err := config.Validate(); err == nil {
+				t.Error("expected validation error for invalid retry config")
+			}
+		})
+	}
+}
+
+func TestValidate_RuleCooldown(t *testing.T) {
+	tests := []struct {
+		name     string
+		cooldown *CooldownConfig
+		wantErr  bool
+	}{
+		{"no cooldown", nil, false},
+		{"per user", &CooldownConfig{Duration: 24 * time.Hour, Scope: "per_user"}, false},
+		{"default scope", &CooldownConfig{Duration: time.Hour}, false},
+		{"zero duration", &CooldownConfig{Scope: "global"}, true},
+		{"unknown scope", &CooldownConfig{Duration: time.Hour, Scope: "per_team"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				Rules: []RuleConfig{
+					{ID: "losing-streak", Type: "losing_streak", Enabled: true, Cooldown: tt.cooldown},
+				},
+			}
+
+			err := config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_RuleConditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions map[string]interface{}
+		wantErr    bool
+	}{
+		{"no conditions", nil, false},
+		{"valid", map[string]interface{}{"long_streak": "signal.current_streak >= 5 && state.active_interventions == 0"}, false},
+		{"syntax error", map[string]interface{}{"long_streak": "signal.current_streak >="}, true},
+		{"unknown variable", map[string]interface{}{"long_streak": "streak >= 5"}, true},
+		{"not bool", map[string]interface{}{"long_streak": "state.signal_count"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				Rules: []RuleConfig{
+					{ID: "losing-streak", Type: "losing_streak", Enabled: true, Conditions: tt.conditions},
+				},
+			}
+
+			err := config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_Mode(t *testing.T) {
+	tests := []struct {
+		name       string
+		ruleMode   string
+		actionMode string
+		wantErr    bool
+	}{
+		{"default", "", "", false},
+		{"live", "live", "live", false},
+		{"shadow", "shadow", "shadow", false},
+		{"unknown rule mode", "dry-run", "", true},
+		{"unknown action mode", "", "test", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				Rules:   []RuleConfig{{ID: "losing-streak", Type: "losing_streak", Enabled: true, Mode: tt.ruleMode}},
+				Actions: []ActionConfig{{ID: "grant-item", Type: "grant_item", Enabled: true, Mode: tt.actionMode}},
+			}
+
+			err := config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_Signals(t *testing.T) {
+	configContent := `
+signals:
+  - stat_code: rse-match-abandoned
+    type: match_abandoned
+    value: inc
+    metadata: [game_mode]
+  - stat_code: rse-daily-logins
+    type: daily_logins
+`
+
+	config, err := ParseConfig([]byte(configContent))
+	if err != nil {
+		t.Fatalf("ParseConfig failed: %v", err)
+	}
+
+	if len(config.Signals) != 2 {
+		t.Fatalf("expected 2 signals, got %d", len(config.Signals))
+	}
+
+	mapping := config.Signals[0].StatMapping()
+	if mapping.StatCode != "rse-match-abandoned" || mapping.SignalType != "match_abandoned" ||
+		mapping.Value != "inc" || len(mapping.Metadata) != 1 || mapping.Metadata[0] != "game_mode" {
+		t.Errorf("unexpected stat mapping: %+v", mapping)
+	}
+	if mapping := config.Signals[1].StatMapping(); mapping.Value != "latest_value" {
+		t.Errorf("expected value to default to latest_value, got %q", mapping.Value)
+	}
+}
+
+func TestValidate_Signals(t *testing.T) {
+	tests := []struct {
+		name    string
+		signals []SignalConfig
+		wantErr string
+	}{
+		{"valid", []SignalConfig{{StatCode: "rse-match-abandoned", Type: "match_abandoned", Value: "latest_value"}}, ""},
+		{"empty stat code", []SignalConfig{{Type: "match_abandoned"}}, "signal with empty stat code found"},
+		{"duplicate stat code", []SignalConfig{
+			{StatCode: "rse-match-abandoned", Type: "match_abandoned"},
+			{StatCode: "rse-match-abandoned", Type: "abandoned"},
+		}, "duplicate signal stat code: rse-match-abandoned"},
+		{"empty type", []SignalConfig{{StatCode: "rse-match-abandoned"}}, "signal rse-match-abandoned has empty type"},
+		{"unknown value", []SignalConfig{{StatCode: "rse-match-abandoned", Type: "match_abandoned", Value: "total"}},
+			`signal rse-match-abandoned has invalid value "total" (must be "latest_value" or "inc")`},
+		{"reserved metadata", []SignalConfig{{StatCode: "rse-match-abandoned", Type: "match_abandoned", Metadata: []string{"value"}}},
+			`signal rse-match-abandoned has reserved metadata field "value"`},
+		{"reserved match metadata", []SignalConfig{{StatCode: "rse-match-abandoned", Type: "match_abandoned", Metadata: []string{"mode", "match_mode"}}},
+			`signal rse-match-abandoned has reserved metadata field "match_mode"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{Signals: tt.signals}
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
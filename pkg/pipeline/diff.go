@@ -0,0 +1,112 @@
+package pipeline
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DiffConfig describes the differences between two configurations, one line per
+// added, removed or changed signal, rule and action, e.g. "rule losing-streak changed: parameters".
+// Returns nil when the configurations are equivalent.
+func DiffConfig(old, new *Config) []string {
+	var changes []string
+
+	oldSignals := make(map[string]SignalConfig, len(old.Signals))
+	for _, sc := range old.Signals {
+		oldSignals[sc.StatCode] = sc
+	}
+	newSignals := make(map[string]bool, len(new.Signals))
+	for _, sc := range new.Signals {
+		newSignals[sc.StatCode] = true
+
+		prev, ok := oldSignals[sc.StatCode]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("signal %s added", sc.StatCode))
+			continue
+		}
+
+		if fields := changedFields(prev, sc); len(fields) > 0 {
+			changes = append(changes, fmt.Sprintf("signal %s changed: %s", sc.StatCode, strings.Join(fields, ", ")))
+		}
+	}
+	for _, sc := range old.Signals {
+		if !newSignals[sc.StatCode] {
+			changes = append(changes, fmt.Sprintf("signal %s removed", sc.StatCode))
+		}
+	}
+
+	oldRules := make(map[string]RuleConfig, len(old.Rules))
+	for _, rc := range old.Rules {
+		oldRules[rc.ID] = rc
+	}
+	newRules := make(map[string]bool, len(new.Rules))
+	for _, rc := range new.Rules {
+		newRules[rc.ID] = true
+
+		prev, ok := oldRules[rc.ID]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("rule %s added", rc.ID))
+			continue
+		}
+
+		if fields := changedFields(prev, rc); len(fields) > 0 {
+			changes = append(changes, fmt.Sprintf("rule %s changed: %s", rc.ID, strings.Join(fields, ", ")))
+		}
+	}
+	for _, rc := range old.Rules {
+		if !newRules[rc.ID] {
+			changes = append(changes, fmt.Sprintf("rule %s removed", rc.ID))
+		}
+	}
+
+	oldActions := make(map[string]ActionConfig, len(old.Actions))
+	for _, ac := range old.Actions {
+		oldActions[ac.ID] = ac
+	}
+	newActions := make(map[string]bool, len(new.Actions))
+	for _, ac := range new.Actions {
+		newActions[ac.ID] = true
+
+		prev, ok := oldActions[ac.ID]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("action %s added", ac.ID))
+			continue
+		}
+
+		if fields := changedFields(prev, ac); len(fields) > 0 {
+			changes = append(changes, fmt.Sprintf("action %s changed: %s", ac.ID, strings.Join(fields, ", ")))
+		}
+	}
+	for _, ac := range old.Actions {
+		if !newActions[ac.ID] {
+			changes = append(changes, fmt.Sprintf("action %s removed", ac.ID))
+		}
+	}
+
+	return changes
+}
+
+// changedFields returns the YAML names of the fields that differ between two entries of the same struct type.
+func changedFields(old, new interface{}) []string {
+	oldValue := reflect.ValueOf(old)
+	newValue := reflect.ValueOf(new)
+	entryType := oldValue.Type()
+
+	var fields []string
+	for i := 0; i < entryType.NumField(); i++ {
+		if !reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			fields = append(fields, yamlFieldName(entryType.Field(i)))
+		}
+	}
+	return fields
+}
+
+// yamlFieldName returns the YAML key of a struct field, falling back to the Go field name.
+func yamlFieldName(field reflect.StructField) string {
+	tag, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if tag == "" || tag == "-" {
+		return field.Name
+	}
+	return tag
+}
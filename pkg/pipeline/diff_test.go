@@ -0,0 +1,74 @@
+package pipeline
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffConfig(t *testing.T) {
+	old := &Config{
+		Signals: []SignalConfig{
+			{StatCode: "rse-match-abandoned", Type: "match_abandoned"},
+			{StatCode: "rse-daily-logins", Type: "daily_logins"},
+		},
+		Rules: []RuleConfig{
+			{ID: "losing-streak", Type: "losing_streak", Enabled: true, Parameters: map[string]interface{}{"threshold": 5}},
+			{ID: "rage-quit", Type: "rage_quit", Enabled: true},
+		},
+		Actions: []ActionConfig{
+			{ID: "grant-item", Type: "grant_item", Enabled: true},
+		},
+	}
+	new := &Config{
+		Signals: []SignalConfig{
+			{StatCode: "rse-match-abandoned", Type: "match_abandoned", Value: "inc"},
+		},
+		Rules: []RuleConfig{
+			{ID: "losing-streak", Type: "losing_streak", Enabled: false, Parameters: map[string]interface{}{"threshold": 7}},
+			{ID: "session-decline", Type: "session_decline", Enabled: true},
+		},
+		Actions: []ActionConfig{
+			{ID: "grant-item", Type: "grant_item", Enabled: true},
+		},
+	}
+
+	want := []string{
+		"signal rse-match-abandoned changed: value",
+		"signal rse-daily-logins removed",
+		"rule losing-streak changed: enabled, parameters",
+		"rule session-decline added",
+		"rule rage-quit removed",
+	}
+
+	if got := DiffConfig(old, new); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffConfig() = %q, want %q", got, want)
+	}
+}
+
+func TestDiffConfig_Unchanged(t *testing.T) {
+	config := &Config{
+		Rules:   []RuleConfig{{ID: "rage-quit", Type: "rage_quit", Enabled: true}},
+		Actions: []ActionConfig{{ID: "grant-item", Type: "grant_item", Enabled: true}},
+	}
+
+	if got := DiffConfig(config, config); got != nil {
+		t.Errorf("expected no changes, got %q", got)
+	}
+}
+
+func TestParseConfig_Version(t *testing.T) {
+	data := []byte("rules:\n  - id: rage-quit\n    type: rage_quit\n    enabled: true\n")
+
+	config, err := ParseConfig(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if config.Version == "" || config.Version != ConfigVersion(data) {
+		t.Errorf("expected version %q, got %q", ConfigVersion(data), config.Version)
+	}
+
+	if ConfigVersion(append(data, '#')) == config.Version {
+		t.Error("expected different contents to produce a different version")
+	}
+}
@@ -27,6 +27,8 @@ func setupTestProcessor(stateStore service.StateStore, loginSessionTracker servi
 		processor.GetNamespace(),
 		&signalBuiltin.EventProcessorDependencies{
 			LoginTrackingStore: loginSessionTracker,
+			StatCycleStore:     service.NewMemoryStatCycleStore(),
+			SessionTracker:     service.NewMemorySessionTracker(),
 		},
 	)
 
@@ -0,0 +1,215 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+)
+
+const (
+	// DefaultLaneShards is the default number of worker lanes.
+	DefaultLaneShards = 16
+	// DefaultLaneQueueDepth is the default number of pending events per lane.
+	DefaultLaneQueueDepth = 100
+)
+
+// ErrLanesClosed indicates that an event was submitted after the lanes were shut down.
+var ErrLanesClosed = errors.New("pipeline lanes are closed")
+
+// LaneConfig configures per-player ordered processing.
+// Events are sharded by user ID onto a fixed number of worker lanes; each lane processes
+// its events one at a time, so events for one player never race while different players
+// are processed in parallel across lanes.
+type LaneConfig struct {
+	Shards     int // Number of worker lanes
+	QueueDepth int // Maximum pending events per lane before submitters block
+}
+
+// laneExecutor serializes work per key across a fixed set of sharded worker lanes.
+type laneExecutor struct {
+	lanes  []chan *laneJob
+	wg     sync.WaitGroup
+	mu     sync.RWMutex
+	closed bool
+
+	// progress tracks each lane on its own, so that a wedged lane is not hidden by
+	// the others completing their work.
+	progress []progressTracker
+}
+
+// progressTracker tracks whether started jobs complete, to detect a wedged pipeline.
+type progressTracker struct {
+	pending      atomic.Int64 // Jobs queued or running
+	lastProgress atomic.Int64 // Unix nanoseconds of the last completed job, or when work arrived while idle
+}
+
+// start records that a job was queued or started.
+func (t *progressTracker) start() {
+	// Idle workers are not stalled, so the stall clock starts when work arrives
+	if t.pending.Add(1) == 1 {
+		t.lastProgress.Store(time.Now().UnixNano())
+	}
+}
+
+// laneJob is a unit of work queued on a lane.
+type laneJob struct {
+	ctx        context.Context
+	run        func() error
+	enqueuedAt time.Time
+	done       chan error
+}
+
+// newLaneExecutor starts the worker lanes.
+func newLaneExecutor(cfg LaneConfig) *laneExecutor {
+	if cfg.Shards <= 0 {
+		cfg.Shards = DefaultLaneShards
+	}
+	if cfg.QueueDepth <= 0 {
+		cfg.QueueDepth = DefaultLaneQueueDepth
+	}
+
+	e := &laneExecutor{
+		lanes:    make([]chan *laneJob, cfg.Shards),
+		progress: make([]progressTracker, cfg.Shards),
+	}
+
+	for i := range e.lanes {
+		e.lanes[i] = make(chan *laneJob, cfg.QueueDepth)
+		e.wg.Add(1)
+		go e.work(strconv.Itoa(i), e.lanes[i], &e.progress[i])
+	}
+
+	return e
+}
+
+// work processes jobs for a single lane in submission order.
+func (e *laneExecutor) work(lane string, jobs <-chan *laneJob, tracker *progressTracker) {
+	defer e.wg.Done()
+
+	for job := range jobs {
+		metrics.LaneQueueDepth.WithLabelValues(lane).Set(float64(len(jobs)))
+		metrics.LaneQueueLatencySeconds.Observe(time.Since(job.enqueuedAt).Seconds())
+
+		// The submitter gave up waiting; don't process an event nobody will acknowledge
+		if err := job.ctx.Err(); err != nil {
+			tracker.complete()
+			job.done <- err
+			continue
+		}
+
+		err := job.run()
+		tracker.complete()
+		job.done <- err
+	}
+}
+
+// complete records that a job finished.
+func (t *progressTracker) complete() {
+	t.lastProgress.Store(time.Now().UnixNano())
+	t.pending.Add(-1)
+}
+
+// progress returns the number of pending jobs and when the last one completed.
+func (t *progressTracker) progress() (int, time.Time) {
+	return int(t.pending.Load()), time.Unix(0, t.lastProgress.Load())
+}
+
+// lanesProgress returns the number of jobs pending on all lanes, and the least recent
+// progress of the lanes with pending jobs, i.e. of the lane stalled the longest.
+// Without pending jobs it returns the most recent progress of any lane.
+func (e *laneExecutor) lanesProgress() (int, time.Time) {
+	total := 0
+	var stalled, latest time.Time
+	for i := range e.progress {
+		pending, lastProgress := e.progress[i].progress()
+		if lastProgress.After(latest) {
+			latest = lastProgress
+		}
+		if pending <= 0 {
+			continue
+		}
+		total += pending
+		if stalled.IsZero() || lastProgress.Before(stalled) {
+			stalled = lastProgress
+		}
+	}
+	if total == 0 {
+		return 0, latest
+	}
+	return total, stalled
+}
+
+// submit queues run on the lane owning key and waits for it to complete.
+// Blocks while the lane queue is full, applying backpressure to the caller.
+func (e *laneExecutor) submit(ctx context.Context, key string, run func() error) error {
+	job := &laneJob{
+		ctx:        ctx,
+		run:        run,
+		enqueuedAt: time.Now(),
+		done:       make(chan error, 1),
+	}
+
+	e.mu.RLock()
+	if e.closed {
+		e.mu.RUnlock()
+		return ErrLanesClosed
+	}
+
+	idx := laneIndex(key, len(e.lanes))
+	e.progress[idx].start()
+
+	select {
+	case e.lanes[idx] <- job:
+		metrics.LaneQueueDepth.WithLabelValues(strconv.Itoa(idx)).Set(float64(len(e.lanes[idx])))
+		e.mu.RUnlock()
+	case <-ctx.Done():
+		e.progress[idx].pending.Add(-1)
+		e.mu.RUnlock()
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-job.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// shutdown stops accepting work and waits for queued jobs to finish.
+func (e *laneExecutor) shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	if !e.closed {
+		e.closed = true
+		for _, lane := range e.lanes {
+			close(lane)
+		}
+	}
+	e.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		e.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// laneIndex maps a key onto a lane using FNV-1a.
+func laneIndex(key string, lanes int) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(lanes))
+}
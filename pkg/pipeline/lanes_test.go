@@ -0,0 +1,268 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// concurrencyTracker records the maximum number of jobs running at once.
+type concurrencyTracker struct {
+	running int32
+	max     int32
+}
+
+func (c *concurrencyTracker) run(d time.Duration) {
+	n := atomic.AddInt32(&c.running, 1)
+	for {
+		m := atomic.LoadInt32(&c.max)
+		if n <= m || atomic.CompareAndSwapInt32(&c.max, m, n) {
+			break
+		}
+	}
+	time.Sleep(d)
+	atomic.AddInt32(&c.running, -1)
+}
+
+func TestLaneExecutor_SameKeySerialized(t *testing.T) {
+	lanes := newLaneExecutor(LaneConfig{Shards: 4, QueueDepth: 10})
+	defer lanes.shutdown(context.Background())
+
+	tracker := &concurrencyTracker{}
+	var mu sync.Mutex
+	var order []int
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			lanes.submit(context.Background(), "same-user", func() error {
+				tracker.run(10 * time.Millisecond)
+				mu.Lock()
+				order = append(order, i)
+				mu.Unlock()
+				return nil
+			})
+		}(i)
+		// Stagger submissions so arrival order is deterministic
+		time.Sleep(time.Millisecond)
+	}
+	wg.Wait()
+
+	if tracker.max != 1 {
+		t.Errorf("expected events for one user to run one at a time, got max concurrency %d", tracker.max)
+	}
+
+	for i, v := range order {
+		if v != i {
+			t.Fatalf("expected events in arrival order, got %v", order)
+		}
+	}
+}
+
+func TestLaneExecutor_DifferentKeysParallel(t *testing.T) {
+	lanes := newLaneExecutor(LaneConfig{Shards: 8, QueueDepth: 10})
+	defer lanes.shutdown(context.Background())
+
+	// Pick keys that hash onto different lanes
+	keys := []string{}
+	seen := map[int]bool{}
+	for i := 0; len(keys) < 3; i++ {
+		key := "user-" + string(rune('a'+i))
+		if idx := laneIndex(key, 8); !seen[idx] {
+			seen[idx] = true
+			keys = append(keys, key)
+		}
+	}
+
+	tracker := &concurrencyTracker{}
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			lanes.submit(context.Background(), key, func() error {
+				tracker.run(50 * time.Millisecond)
+				return nil
+			})
+		}(key)
+	}
+	wg.Wait()
+
+	if tracker.max < 2 {
+		t.Errorf("expected different users to be processed in parallel, got max concurrency %d", tracker.max)
+	}
+}
+
+func TestLaneExecutor_ReturnsJobError(t *testing.T) {
+	lanes := newLaneExecutor(LaneConfig{Shards: 1, QueueDepth: 1})
+	defer lanes.shutdown(context.Background())
+
+	want := errors.New("pipeline failed")
+	if err := lanes.submit(context.Background(), "user", func() error { return want }); err != want {
+		t.Errorf("expected job error to be returned, got %v", err)
+	}
+}
+
+func TestLaneExecutor_ShutdownDrainsAndRejects(t *testing.T) {
+	lanes := newLaneExecutor(LaneConfig{Shards: 1, QueueDepth: 10})
+
+	var processed int32
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lanes.submit(context.Background(), "user", func() error {
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(&processed, 1)
+				return nil
+			})
+		}()
+	}
+	// Let the submissions reach the queue before shutting down
+	time.Sleep(2 * time.Millisecond)
+
+	if err := lanes.shutdown(context.Background()); err != nil {
+		t.Fatalf("expected clean shutdown, got %v", err)
+	}
+	wg.Wait()
+
+	if processed != 3 {
+		t.Errorf("expected queued events to be drained, got %d processed", processed)
+	}
+
+	if err := lanes.submit(context.Background(), "user", func() error { return nil }); err != ErrLanesClosed {
+		t.Errorf("expected ErrLanesClosed after shutdown, got %v", err)
+	}
+}
+
+func TestManager_CheckProgress(t *testing.T) {
+	m := &Manager{}
+	if err := m.CheckProgress(time.Millisecond); err != nil {
+		t.Errorf("expected no error while idle, got %v", err)
+	}
+
+	m.EnableLanes(LaneConfig{Shards: 1, QueueDepth: 10})
+	defer m.lanes.shutdown(context.Background())
+
+	time.Sleep(5 * time.Millisecond)
+	if err := m.CheckProgress(time.Millisecond); err != nil {
+		t.Errorf("expected idle lanes not to be stalled, got %v", err)
+	}
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		m.lanes.submit(context.Background(), "user", func() error {
+			<-release
+			return nil
+		})
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	if progress := m.Progress(); progress.Pending != 1 {
+		t.Errorf("expected 1 pending event, got %d", progress.Pending)
+	}
+	if err := m.CheckProgress(time.Second); err != nil {
+		t.Errorf("expected no stall within the timeout, got %v", err)
+	}
+	if err := m.CheckProgress(time.Millisecond); err == nil {
+		t.Error("expected a wedged event to be reported as a stall")
+	}
+
+	close(release)
+	<-done
+
+	if progress := m.Progress(); progress.Pending != 0 {
+		t.Errorf("expected no pending events, got %d", progress.Pending)
+	}
+	if err := m.CheckProgress(time.Millisecond); err != nil {
+		t.Errorf("expected progress after the event completed, got %v", err)
+	}
+}
+
+func TestManager_CheckProgress_WedgedLaneAmongBusyLanes(t *testing.T) {
+	m := &Manager{}
+	m.EnableLanes(LaneConfig{Shards: 2, QueueDepth: 10})
+	defer m.lanes.shutdown(context.Background())
+
+	// Find a player on each lane
+	wedgedUser, busyUser := "user-0", ""
+	for i := 1; busyUser == ""; i++ {
+		if key := "user-" + strconv.Itoa(i); laneIndex(key, 2) != laneIndex(wedgedUser, 2) {
+			busyUser = key
+		}
+	}
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		m.lanes.submit(context.Background(), wedgedUser, func() error {
+			<-release
+			return nil
+		})
+		close(done)
+	}()
+
+	// The other lane keeps completing events while the first one is wedged
+	deadline := time.Now().Add(30 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		if err := m.lanes.submit(context.Background(), busyUser, func() error { return nil }); err != nil {
+			t.Fatalf("submit() error = %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if progress := m.Progress(); progress.Pending != 1 {
+		t.Errorf("expected 1 pending event, got %d", progress.Pending)
+	}
+	if err := m.CheckProgress(20 * time.Millisecond); err == nil {
+		t.Error("expected the wedged lane to be reported as a stall")
+	}
+
+	close(release)
+	<-done
+
+	if err := m.CheckProgress(20 * time.Millisecond); err != nil {
+		t.Errorf("expected progress after the event completed, got %v", err)
+	}
+}
+
+func TestManager_CheckProgress_WithoutLanes(t *testing.T) {
+	m := &Manager{}
+	if err := m.CheckProgress(time.Millisecond); err != nil {
+		t.Errorf("expected no error while idle, got %v", err)
+	}
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		m.processInLane(context.Background(), "user", func() error {
+			<-release
+			return nil
+		})
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	if progress := m.Progress(); progress.Pending != 1 {
+		t.Errorf("expected 1 pending event, got %d", progress.Pending)
+	}
+	if err := m.CheckProgress(time.Millisecond); err == nil {
+		t.Error("expected a wedged event to be reported as a stall")
+	}
+
+	close(release)
+	<-done
+
+	if err := m.CheckProgress(time.Millisecond); err != nil {
+		t.Errorf("expected progress after the event completed, got %v", err)
+	}
+}
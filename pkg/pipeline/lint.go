@@ -0,0 +1,412 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
+	"gopkg.in/yaml.v3"
+)
+
+// Lint issue severities. Errors make a configuration unusable or wrong at runtime;
+// warnings point at configuration that is likely a mistake.
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// LintIssue is a problem found in a configuration file.
+type LintIssue struct {
+	Line     int
+	Column   int
+	Severity string
+	Message  string
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s", i.Line, i.Column, i.Severity, i.Message)
+}
+
+// LintFactories create signal mappings, rules and actions from configuration entries,
+// to check their types and parameters. A nil factory skips the check.
+type LintFactories struct {
+	CreateSignal func(config SignalConfig) error
+	CreateRule   func(config RuleConfig) error
+	CreateAction func(config ActionConfig) error
+}
+
+// Lint checks configuration file contents and returns every issue found, sorted by position.
+// It decodes and validates the configuration as ParseConfig does, but instead of stopping at
+// the first error it reports all of them and where in the file each problem is. Beyond
+// ParseConfig's checks it reports unknown fields, unset environment variables, signal mappings,
+// rule and action types or parameters the factories reject, rules using disabled actions, rules
+// without actions and actions no enabled rule uses.
+func Lint(data []byte, factories LintFactories) []LintIssue {
+	l := &linter{factories: factories}
+	l.checkEnvVars(string(data))
+
+	// Expanding variables does not move lines, unless a value contains a newline.
+	config, doc, err := decodeConfig(data)
+	var typeErr *yaml.TypeError
+	if err != nil && !errors.As(err, &typeErr) {
+		l.addYAMLError(err)
+		return l.sorted()
+	}
+	if doc == nil {
+		l.issues = append(l.issues, LintIssue{Line: 1, Column: 1, Severity: LintError, Message: "configuration is empty"})
+		return l.sorted()
+	}
+	if doc.Kind != yaml.MappingNode {
+		l.add(doc, LintError, "configuration must be a mapping with rules and actions")
+		return l.sorted()
+	}
+	if err != nil {
+		// Fields in error are left unset; the rest of the configuration is still checked
+		l.addYAMLError(err)
+	}
+
+	l.doc = doc
+	l.entries = map[string][]*yaml.Node{
+		"signals": entryNodes(mappingValue(doc, "signals")),
+		"rules":   entryNodes(mappingValue(doc, "rules")),
+		"actions": entryNodes(mappingValue(doc, "actions")),
+	}
+	l.checkFields(doc, reflect.TypeOf(Config{}), "configuration")
+	l.checkEntryFields("signals", reflect.TypeOf(SignalConfig{}), "signal")
+	l.checkEntryFields("rules", reflect.TypeOf(RuleConfig{}), "rule")
+	l.checkEntryFields("actions", reflect.TypeOf(ActionConfig{}), "action")
+
+	if len(config.Rules) == 0 {
+		l.add(doc, LintWarning, "no rules configured")
+	}
+
+	invalid := l.checkValidation(config)
+	l.checkSignals(config, invalid)
+	l.checkRules(config, invalid)
+	l.checkActions(config, invalid)
+
+	return l.sorted()
+}
+
+type linter struct {
+	factories LintFactories
+	issues    []LintIssue
+
+	// doc is the configuration document and entries the nodes of the signal, rule and
+	// action entries by section, in the order of the decoded configuration.
+	doc     *yaml.Node
+	entries map[string][]*yaml.Node
+}
+
+// entryKey identifies an entry of a configuration by section and position.
+type entryKey struct {
+	section string
+	index   int
+}
+
+func (l *linter) add(node *yaml.Node, severity, format string, args ...interface{}) {
+	l.issues = append(l.issues, LintIssue{
+		Line:     node.Line,
+		Column:   node.Column,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) sorted() []LintIssue {
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		if l.issues[i].Line != l.issues[j].Line {
+			return l.issues[i].Line < l.issues[j].Line
+		}
+		return l.issues[i].Column < l.issues[j].Column
+	})
+	return l.issues
+}
+
+// entry returns the node of an entry, falling back to the document.
+func (l *linter) entry(section string, index int) *yaml.Node {
+	if nodes := l.entries[section]; index < len(nodes) {
+		return nodes[index]
+	}
+	return l.doc
+}
+
+var (
+	envVarPattern    = regexp.MustCompile(`\$\{([^}:]+)\}`)
+	yamlLinePattern  = regexp.MustCompile(`^line (\d+): (.*)$`)
+	yamlErrorPattern = regexp.MustCompile(`^yaml: (.*)$`)
+)
+
+// checkEnvVars warns about ${VAR} references without a default whose variable is unset,
+// as they expand to an empty value.
+func (l *linter) checkEnvVars(data string) {
+	for i, line := range strings.Split(data, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		for _, match := range envVarPattern.FindAllStringSubmatchIndex(line, -1) {
+			name := line[match[2]:match[3]]
+			if os.Getenv(name) == "" {
+				l.issues = append(l.issues, LintIssue{
+					Line:     i + 1,
+					Column:   match[0] + 1,
+					Severity: LintWarning,
+					Message:  fmt.Sprintf("environment variable %s is not set and has no default; it expands to an empty value", name),
+				})
+			}
+		}
+	}
+}
+
+// addYAMLError reports a YAML syntax or type error at the line it names.
+func (l *linter) addYAMLError(err error) {
+	messages := []string{err.Error()}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+
+	for _, message := range messages {
+		if m := yamlErrorPattern.FindStringSubmatch(message); m != nil {
+			message = m[1]
+		}
+		issue := LintIssue{Line: 1, Column: 1, Severity: LintError, Message: message}
+		if m := yamlLinePattern.FindStringSubmatch(message); m != nil {
+			issue.Line, _ = strconv.Atoi(m[1])
+			issue.Message = m[2]
+		}
+		l.issues = append(l.issues, issue)
+	}
+}
+
+// checkFields reports keys of a mapping that do not match a YAML field of typ, and
+// checks nested structs such as cooldown and retry the same way.
+func (l *linter) checkFields(node *yaml.Node, typ reflect.Type, what string) {
+	fields := yamlFields(typ)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		field, ok := fields[key.Value]
+		if !ok {
+			l.add(key, LintError, "unknown field %q in %s", key.Value, what)
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && value.Kind == yaml.MappingNode {
+			l.checkFields(value, fieldType, key.Value)
+		}
+	}
+}
+
+// yamlFields returns the struct fields of typ by YAML key.
+func yamlFields(typ reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+// mappingKey returns the key node of a mapping entry, or nil if absent.
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+// mappingValue returns the value node of a mapping entry, or nil if absent.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// fieldNode returns the key node of field in an entry, falling back to the entry itself.
+func fieldNode(entry *yaml.Node, field string) *yaml.Node {
+	if key := mappingKey(entry, field); key != nil {
+		return key
+	}
+	return entry
+}
+
+// entryNodes returns the items of a section the decoder turns into entries: mappings, and
+// nulls, which decode to empty entries. The decoder reports anything else.
+func entryNodes(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var items []*yaml.Node
+	for _, item := range node.Content {
+		if item.Kind == yaml.MappingNode || item.Tag == "!!null" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// checkEntryFields reports unknown fields of the entries of a section.
+func (l *linter) checkEntryFields(section string, typ reflect.Type, what string) {
+	for _, item := range l.entries[section] {
+		l.checkFields(item, typ, what)
+	}
+}
+
+// checkValidation reports the validation errors of ParseConfig at the entries and fields
+// they are about. It returns the entries in error, which get no further checks; unknown
+// action references do not make a rule invalid.
+func (l *linter) checkValidation(config *Config) map[entryKey]bool {
+	invalid := make(map[entryKey]bool)
+	for _, err := range config.validateEntries() {
+		field := errorField(err)
+		l.add(fieldNode(l.entry(err.Section, err.Index), field), LintError, "%v", err)
+		if field != "actions" {
+			invalid[entryKey{err.Section, err.Index}] = true
+		}
+	}
+	return invalid
+}
+
+func (l *linter) checkSignals(config *Config, invalid map[entryKey]bool) {
+	if l.factories.CreateSignal == nil {
+		return
+	}
+
+	for i, sc := range config.Signals {
+		if invalid[entryKey{"signals", i}] {
+			continue
+		}
+		if err := l.factories.CreateSignal(sc); err != nil {
+			l.add(fieldNode(l.entry("signals", i), "stat_code"), LintError, "signal %s: %v", sc.StatCode, err)
+		}
+	}
+}
+
+func (l *linter) checkRules(config *Config, invalid map[entryKey]bool) {
+	actions := make(map[string]int)
+	for i := len(config.Actions) - 1; i >= 0; i-- {
+		actions[config.Actions[i].ID] = i
+	}
+
+	for i, rc := range config.Rules {
+		if invalid[entryKey{"rules", i}] {
+			continue
+		}
+		node := l.entry("rules", i)
+
+		if l.factories.CreateRule != nil {
+			if err := l.factories.CreateRule(rc); err != nil {
+				l.addCreateError(node, severity(rc.Enabled), "rule "+rc.ID, err)
+			}
+		}
+
+		if rc.Enabled && len(rc.Actions) == 0 {
+			l.add(node, LintWarning, "rule %s has no actions", rc.ID)
+		}
+
+		refs := mappingValue(node, "actions")
+		for j, actionID := range rc.Actions {
+			a, ok := actions[actionID]
+			if !ok || !rc.Enabled || config.Actions[a].Enabled {
+				continue
+			}
+
+			ref := fieldNode(node, "actions")
+			if refs != nil && refs.Kind == yaml.SequenceNode && j < len(refs.Content) {
+				ref = refs.Content[j]
+			}
+			l.add(ref, LintError, "rule %s references disabled action %s (defined on line %d)", rc.ID, actionID, l.entry("actions", a).Line)
+		}
+	}
+}
+
+func (l *linter) checkActions(config *Config, invalid map[entryKey]bool) {
+	used := make(map[string]bool)
+	for _, rc := range config.Rules {
+		if rc.Enabled {
+			for _, actionID := range rc.Actions {
+				used[actionID] = true
+			}
+		}
+	}
+
+	for i, ac := range config.Actions {
+		if invalid[entryKey{"actions", i}] {
+			continue
+		}
+		node := l.entry("actions", i)
+
+		if l.factories.CreateAction != nil {
+			if err := l.factories.CreateAction(ac); err != nil {
+				l.addCreateError(node, severity(ac.Enabled), "action "+ac.ID, err)
+			}
+		}
+
+		if ac.Enabled && !used[ac.ID] {
+			l.add(node, LintWarning, "action %s is not used by any enabled rule", ac.ID)
+		}
+	}
+}
+
+// addCreateError reports why a factory could not create an entry. Parameter errors are
+// reported at each parameter, anything else at the entry's type.
+func (l *linter) addCreateError(entry *yaml.Node, severity, what string, err error) {
+	var paramErrs param.Errors
+	if !errors.As(err, &paramErrs) {
+		l.add(fieldNode(entry, "type"), severity, "%s: %v", what, err)
+		return
+	}
+
+	params := mappingValue(entry, "parameters")
+	for _, paramErr := range paramErrs {
+		node := fieldNode(entry, "parameters")
+		if key := mappingKey(params, paramErr.Param); key != nil {
+			node = key
+		}
+		l.add(node, severity, "%s: parameter %v", what, paramErr)
+	}
+}
+
+// errorField returns the field a validation error is about, or "" if unknown.
+func errorField(err error) string {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return fieldErr.Field
+	}
+	return ""
+}
+
+// severity reports problems of disabled entries as warnings: they do not break the
+// pipeline until the entry is enabled.
+func severity(enabled bool) string {
+	if enabled {
+		return LintError
+	}
+	return LintWarning
+}
@@ -0,0 +1,213 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
+)
+
+func TestLint_ValidConfig(t *testing.T) {
+	data := `
+rules:
+  - id: rage-quit
+    type: rage_quit
+    enabled: true
+    actions: [dispatch-comeback-challenge]
+    cooldown:
+      duration: 24h
+      scope: per_user
+
+actions:
+  - id: dispatch-comeback-challenge
+    type: dispatch_comeback_challenge
+    enabled: true
+    retry:
+      max_attempts: 3
+`
+
+	if issues := Lint([]byte(data), LintFactories{}); len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+}
+
+func TestLint_ReportsEveryIssueWithPosition(t *testing.T) {
+	data := `rules:
+  - id: rage-quit
+    type: rage_quitt
+    enabeld: true
+    enabled: true
+    actions: [disabled-action, missing-action]
+    cooldown:
+      duration: 24h
+      per_user: true
+  - id: rage-quit
+    type: losing_streak
+    enabled: true
+    actions: [grant-item]
+  - id: streak
+    type: losing_streak
+    enabled: true
+    mode: shaddow
+    actions: [grant-item]
+
+actions:
+  - id: disabled-action
+    type: dispatch_comeback_challenge
+    enabled: false
+  - id: grant-item
+    type: grant_item
+    enabled: true
+    parameters:
+      item_id: ${LINT_TEST_UNSET_VARIABLE}
+  - id: unused
+    type: grant_item
+    enabled: true
+`
+
+	factories := LintFactories{
+		CreateRule: func(config RuleConfig) error {
+			if config.Type == "rage_quitt" {
+				return fmt.Errorf("unknown rule type: %s", config.Type)
+			}
+			return nil
+		},
+	}
+
+	var got []string
+	for _, issue := range Lint([]byte(data), factories) {
+		got = append(got, issue.String())
+	}
+
+	expected := []string{
+		`3:5: error: rule rage-quit: unknown rule type: rage_quitt`,
+		`4:5: error: unknown field "enabeld" in rule`,
+		`6:5: error: rule rage-quit references unknown action: missing-action`,
+		`6:15: error: rule rage-quit references disabled action disabled-action (defined on line 21)`,
+		`9:7: error: unknown field "per_user" in cooldown`,
+		`10:5: error: duplicate rule ID: rage-quit`,
+		`17:5: error: rule streak has invalid mode: unknown mode "shaddow" (expected live or shadow)`,
+		`28:16: warning: environment variable LINT_TEST_UNSET_VARIABLE is not set and has no default; it expands to an empty value`,
+		`29:5: warning: action unused is not used by any enabled rule`,
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected issues:\ngot:\n%s\n\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestLint_ReportsParameterErrorsAtParameter(t *testing.T) {
+	data := `rules:
+  - id: streak
+    type: losing_streak
+    enabled: true
+    actions: [grant-item]
+    parameters:
+      threshold: "5"
+
+actions:
+  - id: grant-item
+    type: grant_item
+    enabled: true
+`
+
+	schemas := map[string]param.Schema{
+		"losing_streak": {{Name: "threshold", Type: param.TypeInt}},
+		"grant_item":    {{Name: "item_id", Type: param.TypeString, Required: true}},
+	}
+	factories := LintFactories{
+		CreateRule: func(config RuleConfig) error {
+			return schemas[config.Type].Validate(config.Parameters)
+		},
+		CreateAction: func(config ActionConfig) error {
+			return schemas[config.Type].Validate(config.Parameters)
+		},
+	}
+
+	var got []string
+	for _, issue := range Lint([]byte(data), factories) {
+		got = append(got, issue.String())
+	}
+
+	expected := []string{
+		`7:7: error: rule streak: parameter threshold must be an integer, got string "5"`,
+		`10:5: error: action grant-item: parameter item_id is required`,
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected issues:\ngot:\n%s\n\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestLint_Signals(t *testing.T) {
+	data := `signals:
+  - stat_code: rse-match-abandoned
+    type: match_abandoned
+    metadata: [game_mode]
+  - stat_code: rse-match-abandoned
+    type: abandoned
+  - stat_code: rse-rage-quit
+    type: rage_quits
+  - stat_code: rse-daily-logins
+    type: daily_logins
+    value: total
+    field: x
+
+rules:
+  - id: abandoned
+    type: threshold
+    enabled: true
+    actions: [grant-item]
+
+actions:
+  - id: grant-item
+    type: grant_item
+    enabled: true
+`
+
+	factories := LintFactories{
+		CreateSignal: func(config SignalConfig) error {
+			if config.StatCode == "rse-rage-quit" {
+				return fmt.Errorf("stat code rse-rage-quit is handled by a built-in event processor")
+			}
+			return nil
+		},
+	}
+
+	var got []string
+	for _, issue := range Lint([]byte(data), factories) {
+		got = append(got, issue.String())
+	}
+
+	expected := []string{
+		`5:5: error: duplicate signal stat code: rse-match-abandoned`,
+		`7:5: error: signal rse-rage-quit: stat code rse-rage-quit is handled by a built-in event processor`,
+		`11:5: error: signal rse-daily-logins has invalid value "total" (must be "latest_value" or "inc")`,
+		`12:5: error: unknown field "field" in signal`,
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected issues:\ngot:\n%s\n\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestLint_InvalidYAML(t *testing.T) {
+	data := `rules:
+  - id: rage-quit
+    type: rage_quit
+    enabled: maybe
+`
+
+	issues := Lint([]byte(data), LintFactories{})
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %v", issues)
+	}
+	if issues[0].Line != 4 || issues[0].Severity != LintError {
+		t.Errorf("expected an error on line 4, got %v", issues[0])
+	}
+}
+
+func TestConfigValidate_FieldError(t *testing.T) {
+	err := RuleConfig{ID: "r", Type: "rage_quit", Mode: "loud"}.Validate()
+	if errorField(err) != "mode" {
+		t.Errorf("expected a mode field error, got %v", err)
+	}
+}
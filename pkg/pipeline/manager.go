@@ -2,24 +2,65 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/audit"
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 	asyncapi_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
 	asyncapi_social "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 )
 
+const (
+	// eventTypeOAuthTokenGenerated identifies OAuth token generated events for deduplication.
+	eventTypeOAuthTokenGenerated = "oauth_token_generated"
+	// eventTypeStatItemUpdated identifies stat item updated events for deduplication.
+	eventTypeStatItemUpdated = "stat_item_updated"
+
+	// handlerOAuth and handlerStatistic label events from the typed handlers in metrics.
+	// Events routed through ProcessEvent are labelled with their event type.
+	handlerOAuth     = "oauth"
+	handlerStatistic = "statistic"
+)
+
+// ErrEventInProgress is returned for a duplicate of an event that another delivery is
+// processing. The duplicate is not dropped, since the other delivery may still fail.
+var ErrEventInProgress = errors.New("event is being processed by another delivery")
+
 // Manager orchestrates the complete churn intervention pipeline:
 // Event → Signal → Rules → Actions
 type Manager struct {
 	signalProcessor *signal.Processor
-	engine          *rule.Engine
-	executor        *action.Executor
-	ruleActions     map[string][]string // Maps rule ID to action IDs
-	logger          *slog.Logger
+	active          atomic.Pointer[activeConfig]
+	// namespaceConfigs maps namespaces with their own configuration to their *activeConfig.
+	// Other namespaces use the active configuration.
+	namespaceConfigs sync.Map
+	deduplicator     service.EventDeduplicator
+	latenessWindow   time.Duration
+	clock            clock.Clock
+	auditSink        audit.Sink
+	lanes            *laneExecutor
+	inline           progressTracker // Progress of events processed without lanes
+	logger           *slog.Logger
+}
+
+// activeConfig holds the parts of the pipeline built from pipeline.yaml.
+// It is replaced as a whole on reload, so an event is always evaluated and
+// executed against a single configuration.
+type activeConfig struct {
+	engine      *rule.Engine
+	executor    *action.Executor
+	ruleActions map[string][]string // Maps rule ID to action IDs
 }
 
 // NewManager creates a new pipeline manager with all required components.
@@ -33,24 +74,187 @@ func NewManager(signalProcessor *signal.Processor, engine *rule.Engine, executor
 		ruleActions = make(map[string][]string)
 	}
 
-	return &Manager{
+	m := &Manager{
 		signalProcessor: signalProcessor,
-		engine:          engine,
-		executor:        executor,
-		ruleActions:     ruleActions,
+		clock:           clock.Real{},
 		logger:          logger,
 	}
+	m.active.Store(&activeConfig{
+		engine:      engine,
+		executor:    executor,
+		ruleActions: ruleActions,
+	})
+	return m
+}
+
+// Reload atomically replaces the rules, actions and rule-to-action mappings.
+// Events already being processed finish with the previous configuration.
+// Rule cooldowns and the async action pool carry over to the new configuration.
+func (m *Manager) Reload(ruleRegistry *rule.Registry, actionRegistry *action.Registry, ruleActions map[string][]string) {
+	if ruleActions == nil {
+		ruleActions = make(map[string][]string)
+	}
+
+	current := m.active.Load()
+	m.active.Store(&activeConfig{
+		engine:      current.engine.WithRegistry(ruleRegistry),
+		executor:    current.executor.WithRegistry(actionRegistry),
+		ruleActions: ruleActions,
+	})
+
+	m.logger.Info("pipeline configuration reloaded",
+		slog.Int("rule_count", ruleRegistry.Count()),
+		slog.Int("action_count", actionRegistry.Count()))
+}
+
+// ReloadNamespace atomically replaces the rules, actions and rule-to-action mappings
+// of one namespace, so that events of the namespace are no longer evaluated against the
+// configuration given to NewManager and Reload. Like Reload, events already being
+// processed finish with the previous configuration.
+func (m *Manager) ReloadNamespace(ns string, ruleRegistry *rule.Registry, actionRegistry *action.Registry, ruleActions map[string][]string) {
+	if ruleActions == nil {
+		ruleActions = make(map[string][]string)
+	}
+
+	// Rule cooldowns, the async action pool and statistics are shared by all namespaces
+	current := m.active.Load()
+	m.namespaceConfigs.Store(ns, &activeConfig{
+		engine:      current.engine.WithRegistry(ruleRegistry),
+		executor:    current.executor.WithRegistry(actionRegistry),
+		ruleActions: ruleActions,
+	})
+
+	m.logger.Info("pipeline configuration reloaded",
+		slog.String("namespace", ns),
+		slog.Int("rule_count", ruleRegistry.Count()),
+		slog.Int("action_count", actionRegistry.Count()))
+}
+
+// config returns the configuration of the namespace ctx is scoped to, falling back
+// to the active configuration for namespaces without their own.
+func (m *Manager) config(ctx context.Context) *activeConfig {
+	if config, ok := m.namespaceConfigs.Load(namespace.FromContext(ctx)); ok {
+		return config.(*activeConfig)
+	}
+	return m.active.Load()
+}
+
+// ClearUserCooldowns ends the per-player rule cooldowns of userID for the rules
+// of the configuration of the namespace ctx is scoped to. Returns the IDs of the affected rules.
+func (m *Manager) ClearUserCooldowns(ctx context.Context, userID string) ([]string, error) {
+	return m.config(ctx).engine.ClearUserCooldowns(ctx, userID)
+}
+
+// withEventNamespace scopes ctx to the namespace of the event, so that stores, AGS calls
+// and metrics of the event use it. Events without namespace belong to the default
+// namespace, the namespace of the signal processor.
+func (m *Manager) withEventNamespace(ctx context.Context, event interface{}) context.Context {
+	defaultNamespace := m.signalProcessor.GetNamespace()
+	return namespace.NewContext(ctx, signal.EventNamespace(event, defaultNamespace), defaultNamespace)
+}
+
+// SetDeduplicator enables event deduplication keyed on the AGS event ID.
+// When set, events whose ID was already processed are skipped (see processOnce).
+func (m *Manager) SetDeduplicator(deduplicator service.EventDeduplicator) {
+	m.deduplicator = deduplicator
+}
+
+// SetLatenessWindow enables dropping late events: events whose AGS timestamp is more than
+// window behind the clock, e.g. when Kafka Connect lags or replays, are skipped. Events
+// within the window are processed in event time, so arriving late or out of order does
+// not change e.g. the week a login counts in. Zero, the default, accepts all events.
+func (m *Manager) SetLatenessWindow(window time.Duration) {
+	m.latenessWindow = window
+}
+
+// SetClock sets the clock that event lateness and audit records are measured against.
+// The wall clock is used until it is called.
+func (m *Manager) SetClock(c clock.Clock) {
+	m.clock = c
+}
+
+// EnableLanes enables per-player ordered processing.
+// Events for the same user ID are processed one at a time in arrival order,
+// while events for different users are processed in parallel across lanes.
+// Call Shutdown to stop the lanes.
+func (m *Manager) EnableLanes(cfg LaneConfig) {
+	m.lanes = newLaneExecutor(cfg)
+}
+
+// Shutdown stops accepting events and waits for queued events to finish processing,
+// then drains async actions dispatched by those events.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	var errs []error
+	if m.lanes != nil {
+		errs = append(errs, m.lanes.shutdown(ctx))
+	}
+	if executor := m.active.Load().executor; executor != nil {
+		// Still called after a lane timeout, so that async actions are cancelled
+		errs = append(errs, executor.Shutdown(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// Progress reports whether queued events are being processed.
+type Progress struct {
+	// Pending is the number of events queued on the lanes or being processed.
+	Pending int `json:"pending"`
+	// LastProgress is when an event last completed, or when events arrived while processing was idle.
+	// With lanes, it is that of the lane with pending events that progressed least recently.
+	LastProgress time.Time `json:"last_progress"`
+}
+
+// Progress returns the progress of event processing, on the lanes when they are enabled.
+func (m *Manager) Progress() Progress {
+	var pending int
+	var lastProgress time.Time
+	if m.lanes != nil {
+		pending, lastProgress = m.lanes.lanesProgress()
+	} else {
+		pending, lastProgress = m.inline.progress()
+	}
+	return Progress{Pending: pending, LastProgress: lastProgress}
+}
+
+// CheckProgress returns an error if events are pending but none has completed for
+// longer than stallTimeout, i.e. the pipeline is wedged. With lanes, each lane with
+// pending events is checked on its own.
+func (m *Manager) CheckProgress(stallTimeout time.Duration) error {
+	progress := m.Progress()
+	if progress.Pending == 0 {
+		return nil
+	}
+	if stalled := time.Since(progress.LastProgress); stalled > stallTimeout {
+		return fmt.Errorf("%d events pending but none completed for %v", progress.Pending, stalled.Round(time.Second))
+	}
+	return nil
 }
 
 // ProcessEvent processes any event through the complete pipeline.
 // eventType identifies which EventProcessor handles this event.
 // event is the raw protobuf message.
 func (m *Manager) ProcessEvent(ctx context.Context, eventType string, event interface{}) error {
+	ctx = m.withEventNamespace(ctx, event)
 	m.logger.Info("processing event through pipeline",
-		slog.String("event_type", eventType))
+		slog.String("event_type", eventType),
+		slog.String("namespace", namespace.FromContext(ctx)))
+	metrics.EventsReceivedTotal.WithLabelValues(namespace.FromContext(ctx), eventType, "").Inc()
+
+	ctx, span := startEventSpan(ctx, eventType, event)
+	err := m.processInLane(ctx, getEventUserID(event), func() error {
+		return m.processOnce(ctx, eventType, event, func() error {
+			return m.processEvent(ctx, eventType, event)
+		})
+	})
+	endEventSpan(span, err)
+	return err
+}
 
+func (m *Manager) processEvent(ctx context.Context, eventType string, event interface{}) error {
 	// Step 1: Convert event to signal
+	start := time.Now()
 	sig, err := m.signalProcessor.ProcessEvent(ctx, eventType, event)
+	metrics.SignalProcessingDurationSeconds.WithLabelValues(eventType).Observe(time.Since(start).Seconds())
 	if err != nil {
 		m.logger.Error("failed to process event to signal",
 			slog.String("event_type", eventType),
@@ -69,17 +273,94 @@ func (m *Manager) ProcessEvent(ctx context.Context, eventType string, event inte
 		slog.String("signal_type", sig.Type()),
 		slog.String("user_id", sig.UserID()))
 
+	if reset, ok := sig.(*signal.StatResetSignal); ok {
+		if err := m.resetStat(ctx, reset); err != nil {
+			return err
+		}
+		if reset.Next != nil {
+			if err := m.evaluateAndExecute(ctx, eventType, getEventID(event), reset); err != nil {
+				return err
+			}
+			// The first value after the reset is evaluated like any other
+			sig = reset.Next
+		}
+	}
+
 	// Step 2: Evaluate rules and execute actions
-	return m.evaluateAndExecute(ctx, sig)
+	return m.evaluateAndExecute(ctx, eventType, getEventID(event), sig)
+}
+
+// resetStat clears what the pipeline derived from the earlier values of a player's stat,
+// so that rules evaluate the values after the reset afresh: the player's cooldowns of the
+// rules handling the stat's signals, and the signals of that type recorded in the player's
+// churn state.
+func (m *Manager) resetStat(ctx context.Context, reset *signal.StatResetSignal) error {
+	signalType, ok := m.signalProcessor.SignalTypeForStat(reset.StatCode)
+	if !ok {
+		m.logger.Warn("stat reset ignored, the event processor of the stat declares no signal type",
+			slog.String("stat_code", reset.StatCode),
+			slog.String("user_id", reset.UserID()))
+		return nil
+	}
+
+	cleared, err := m.config(ctx).engine.ClearUserSignalCooldowns(ctx, reset.UserID(), signalType)
+	if err != nil {
+		return fmt.Errorf("failed to reset stat %s: %w", reset.StatCode, err)
+	}
+
+	if err := m.clearSignals(ctx, reset, signalType); err != nil {
+		return fmt.Errorf("failed to reset stat %s: %w", reset.StatCode, err)
+	}
+
+	m.logger.Info("stat reset",
+		slog.String("stat_code", reset.StatCode),
+		slog.String("reason", reset.Reason),
+		slog.String("user_id", reset.UserID()),
+		slog.Any("cleared_rule_cooldowns", cleared))
+	return nil
+}
+
+// clearSignals removes the signals of signalType from the churn state of the reset's player.
+// The state is updated in place, so that the signals evaluated after the reset see it.
+func (m *Manager) clearSignals(ctx context.Context, reset *signal.StatResetSignal, signalType string) error {
+	playerCtx := reset.Context()
+	if playerCtx == nil || playerCtx.State == nil {
+		return nil
+	}
+	// Recording a signal always records when it was last detected
+	if _, recorded := playerCtx.State.Cooldown.LastSignalAt[signalType]; !recorded {
+		return nil
+	}
+
+	return service.UpdateChurnStateWithRetry(ctx, m.signalProcessor.GetStateStore(), reset.UserID(), playerCtx.State, func(state *service.ChurnState) error {
+		state.ClearSignals(signalType)
+		return nil
+	})
 }
 
 // ProcessOAuthEvent processes an OAuth event through the complete pipeline.
 // This is a convenience wrapper that delegates to the signal processor's typed method.
 func (m *Manager) ProcessOAuthEvent(ctx context.Context, event *asyncapi_iam.OauthTokenGenerated) error {
+	ctx = m.withEventNamespace(ctx, event)
 	m.logger.Info("processing OAuth event through pipeline",
-		slog.String("user_id", event.GetUserId()))
+		slog.String("user_id", event.GetUserId()),
+		slog.String("namespace", namespace.FromContext(ctx)))
+	metrics.EventsReceivedTotal.WithLabelValues(namespace.FromContext(ctx), handlerOAuth, "").Inc()
+
+	ctx, span := startEventSpan(ctx, eventTypeOAuthTokenGenerated, event)
+	err := m.processInLane(ctx, event.GetUserId(), func() error {
+		return m.processOnce(ctx, eventTypeOAuthTokenGenerated, event, func() error {
+			return m.processOAuthEvent(ctx, event)
+		})
+	})
+	endEventSpan(span, err)
+	return err
+}
 
+func (m *Manager) processOAuthEvent(ctx context.Context, event *asyncapi_iam.OauthTokenGenerated) error {
+	start := time.Now()
 	sig, err := m.signalProcessor.ProcessOAuthEvent(ctx, event)
+	metrics.SignalProcessingDurationSeconds.WithLabelValues(handlerOAuth).Observe(time.Since(start).Seconds())
 	if err != nil {
 		return fmt.Errorf("signal processing failed: %w", err)
 	}
@@ -88,17 +369,33 @@ func (m *Manager) ProcessOAuthEvent(ctx context.Context, event *asyncapi_iam.Oau
 		return nil
 	}
 
-	return m.evaluateAndExecute(ctx, sig)
+	return m.evaluateAndExecute(ctx, eventTypeOAuthTokenGenerated, event.GetId(), sig)
 }
 
 // ProcessStatEvent processes a statistic event through the complete pipeline.
 // This is a convenience wrapper that uses the signal processor's stat-code routing.
 func (m *Manager) ProcessStatEvent(ctx context.Context, event *asyncapi_social.StatItemUpdated) error {
+	ctx = m.withEventNamespace(ctx, event)
 	m.logger.Info("processing stat event through pipeline",
 		slog.String("user_id", event.GetUserId()),
-		slog.String("stat_code", event.GetPayload().GetStatCode()))
+		slog.String("stat_code", event.GetPayload().GetStatCode()),
+		slog.String("namespace", namespace.FromContext(ctx)))
+	metrics.EventsReceivedTotal.WithLabelValues(namespace.FromContext(ctx), handlerStatistic, event.GetPayload().GetStatCode()).Inc()
+
+	ctx, span := startEventSpan(ctx, eventTypeStatItemUpdated, event)
+	err := m.processInLane(ctx, getEventUserID(event), func() error {
+		return m.processOnce(ctx, eventTypeStatItemUpdated, event, func() error {
+			return m.processStatEvent(ctx, event)
+		})
+	})
+	endEventSpan(span, err)
+	return err
+}
 
+func (m *Manager) processStatEvent(ctx context.Context, event *asyncapi_social.StatItemUpdated) error {
+	start := time.Now()
 	sig, err := m.signalProcessor.ProcessStatEvent(ctx, event)
+	metrics.SignalProcessingDurationSeconds.WithLabelValues(handlerStatistic).Observe(time.Since(start).Seconds())
 	if err != nil {
 		return fmt.Errorf("signal processing failed: %w", err)
 	}
@@ -107,13 +404,142 @@ func (m *Manager) ProcessStatEvent(ctx context.Context, event *asyncapi_social.S
 		return nil
 	}
 
-	return m.evaluateAndExecute(ctx, sig)
+	return m.evaluateAndExecute(ctx, eventTypeStatItemUpdated, event.GetId(), sig)
+}
+
+// processInLane runs process on the lane owning userID, or inline when lanes are disabled.
+func (m *Manager) processInLane(ctx context.Context, userID string, process func() error) error {
+	if m.lanes == nil {
+		m.inline.start()
+		defer m.inline.complete()
+		return process()
+	}
+	return m.lanes.submit(ctx, userID, process)
+}
+
+// processOnce runs process unless the event was already processed or is too late
+// (see SetLatenessWindow). The event is processed under a lease and only recorded as
+// processed once process succeeded, so that a redelivery of a failed event is retried
+// rather than dropped. A duplicate arriving while the event is in progress elsewhere
+// fails with ErrEventInProgress, so that it is redelivered rather than dropped.
+// Events without an ID, and all events when no deduplicator is set, are always processed.
+func (m *Manager) processOnce(ctx context.Context, eventType string, event interface{}, process func() error) error {
+	if m.isLate(ctx, eventType, event) {
+		return nil
+	}
+
+	eventID := getEventID(event)
+	if m.deduplicator == nil || eventID == "" {
+		return process()
+	}
+
+	status, err := m.deduplicator.AcquireEvent(ctx, eventType, eventID)
+	if err != nil {
+		// Fail open: processing a duplicate is preferable to dropping an event
+		m.logger.Error("event deduplication check failed, processing anyway",
+			slog.String("event_type", eventType),
+			slog.String("event_id", eventID),
+			slog.String("error", err.Error()))
+		return process()
+	}
+
+	switch status {
+	case service.EventProcessed:
+		metrics.DuplicateEventsDroppedTotal.WithLabelValues(namespace.FromContext(ctx), eventType).Inc()
+		m.logger.Info("duplicate event dropped",
+			slog.String("event_type", eventType),
+			slog.String("event_id", eventID))
+		return nil
+	case service.EventInProgress:
+		m.logger.Warn("duplicate of an event in progress, leaving it for redelivery",
+			slog.String("event_type", eventType),
+			slog.String("event_id", eventID))
+		return fmt.Errorf("%w: %s %s", ErrEventInProgress, eventType, eventID)
+	}
+
+	if err := process(); err != nil {
+		if releaseErr := m.deduplicator.ReleaseEvent(ctx, eventType, eventID); releaseErr != nil {
+			m.logger.Error("failed to release event after processing failure",
+				slog.String("event_type", eventType),
+				slog.String("event_id", eventID),
+				slog.String("error", releaseErr.Error()))
+		}
+		return err
+	}
+
+	if err := m.deduplicator.CompleteEvent(ctx, eventType, eventID); err != nil {
+		// Processing succeeded, so the event does not fail: redeliveries are deferred
+		// until the lease expires and processed again after that
+		m.logger.Error("failed to record event as processed",
+			slog.String("event_type", eventType),
+			slog.String("event_id", eventID),
+			slog.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+// isLate reports whether the event occurred longer than the lateness window ago.
+// Events without a valid timestamp are never late.
+func (m *Manager) isLate(ctx context.Context, eventType string, event interface{}) bool {
+	if m.latenessWindow <= 0 {
+		return false
+	}
+
+	eventTime, ok := signal.ParseEventTime(event)
+	if !ok {
+		return false
+	}
+
+	lateness := m.clock.Now().Sub(eventTime)
+	if lateness <= m.latenessWindow {
+		return false
+	}
+
+	metrics.LateEventsDroppedTotal.WithLabelValues(namespace.FromContext(ctx), eventType).Inc()
+	m.logger.Warn("late event dropped",
+		slog.String("event_type", eventType),
+		slog.String("event_id", getEventID(event)),
+		slog.String("user_id", getEventUserID(event)),
+		slog.Time("event_time", eventTime),
+		slog.Duration("lateness", lateness))
+	return true
+}
+
+// getEventID returns the AGS event ID if the event carries one.
+func getEventID(event interface{}) string {
+	if e, ok := event.(interface{ GetId() string }); ok {
+		return e.GetId()
+	}
+	return ""
+}
+
+// getEventUserID returns the user ID of an event, falling back to the payload user ID
+// for events (such as stat updates) that may only carry it there.
+func getEventUserID(event interface{}) string {
+	if e, ok := event.(interface{ GetUserId() string }); ok && e.GetUserId() != "" {
+		return e.GetUserId()
+	}
+	switch e := event.(type) {
+	case *asyncapi_social.StatItemUpdated:
+		return e.GetPayload().GetUserId()
+	case *asyncapi_social.StatItemCreated:
+		return e.GetPayload().GetUserId()
+	case *asyncapi_social.StatItemDeleted:
+		return e.GetPayload().GetUserId()
+	case *asyncapi_social.StatItemCycleUpdated:
+		return e.GetPayload().GetUserId()
+	}
+	return ""
 }
 
 // evaluateAndExecute evaluates rules for a signal and executes triggered actions.
-func (m *Manager) evaluateAndExecute(ctx context.Context, sig signal.Signal) error {
+// eventType and eventID identify the source event in the decision audit log.
+func (m *Manager) evaluateAndExecute(ctx context.Context, eventType, eventID string, sig signal.Signal) error {
+	active := m.config(ctx)
+
 	// Step 2: Evaluate rules against the signal
-	triggers, err := m.engine.Evaluate(ctx, sig)
+	triggers, decisions, err := active.engine.EvaluateWithDecisions(ctx, sig)
 	if err != nil {
 		m.logger.Error("rule evaluation failed",
 			slog.String("signal_type", sig.Type()),
@@ -122,6 +548,9 @@ func (m *Manager) evaluateAndExecute(ctx context.Context, sig signal.Signal) err
 		return fmt.Errorf("rule evaluation failed: %w", err)
 	}
 
+	record := m.newAuditRecord(ctx, eventType, eventID, sig, decisions)
+	defer m.writeAuditRecord(ctx, record)
+
 	if len(triggers) == 0 {
 		m.logger.Debug("no rules triggered for signal",
 			slog.String("signal_type", sig.Type()),
@@ -137,7 +566,7 @@ func (m *Manager) evaluateAndExecute(ctx context.Context, sig signal.Signal) err
 	// Step 3: Execute actions for each trigger
 	for _, trigger := range triggers {
 		// Get action IDs from rule-to-actions mapping
-		actionIDs, ok := m.ruleActions[trigger.RuleID]
+		actionIDs, ok := active.ruleActions[trigger.RuleID]
 		if !ok || len(actionIDs) == 0 {
 			m.logger.Info("trigger has no actions configured",
 				slog.String("rule_id", trigger.RuleID))
@@ -149,17 +578,22 @@ func (m *Manager) evaluateAndExecute(ctx context.Context, sig signal.Signal) err
 			slog.Int("action_count", len(actionIDs)),
 			slog.String("user_id", sig.UserID()))
 
-		// Execute all actions for this trigger with rollback support
-		results, err := m.executor.ExecuteMultiple(ctx, actionIDs, trigger, sig.Context(), true)
+		// Execute all actions for this trigger with rollback support.
+		// Actions are never re-run: actions saving the churn state reapply their update themselves
+		// when the state was saved concurrently (see service.UpdateChurnStateWithRetry).
+		results, err := active.executor.ExecuteMultiple(ctx, actionIDs, trigger, sig.Context(), true)
 		if err != nil {
 			m.logger.Error("action execution encountered error",
 				slog.String("rule_id", trigger.RuleID),
 				slog.String("error", err.Error()))
+			m.releaseCooldown(ctx, active.engine, trigger)
 		}
+		addAuditActions(record, trigger.RuleID, results)
 
 		// Log results
 		successCount := 0
 		failureCount := 0
+		var shadowResults []*action.ActionResult
 		for _, result := range results {
 			if result.Error != nil {
 				failureCount++
@@ -167,6 +601,8 @@ func (m *Manager) evaluateAndExecute(ctx context.Context, sig signal.Signal) err
 					slog.String("action_id", result.ActionID),
 					slog.String("rule_id", trigger.RuleID),
 					slog.String("error", result.Error.Error()))
+			} else if shadow, _ := result.Metadata["shadow"].(bool); shadow {
+				shadowResults = append(shadowResults, result)
 			} else {
 				successCount++
 			}
@@ -175,7 +611,12 @@ func (m *Manager) evaluateAndExecute(ctx context.Context, sig signal.Signal) err
 		m.logger.Info("action execution completed",
 			slog.String("rule_id", trigger.RuleID),
 			slog.Int("success_count", successCount),
-			slog.Int("failure_count", failureCount))
+			slog.Int("failure_count", failureCount),
+			slog.Int("shadow_count", len(shadowResults)))
+
+		if len(shadowResults) > 0 {
+			m.recordShadowResults(ctx, trigger, shadowResults, sig.Context())
+		}
 
 		// If any action failed and we have a partial failure, log a warning
 		if failureCount > 0 && successCount > 0 {
@@ -189,6 +630,44 @@ func (m *Manager) evaluateAndExecute(ctx context.Context, sig signal.Signal) err
 	return nil
 }
 
+// releaseCooldown releases the rule cooldown started by a trigger whose actions failed, so that
+// the next event of the player can trigger the intervention again. Failing to release is logged.
+func (m *Manager) releaseCooldown(ctx context.Context, engine *rule.Engine, trigger *rule.Trigger) {
+	if err := engine.ReleaseCooldown(ctx, trigger); err != nil {
+		m.logger.Error("failed to release cooldown after action failure",
+			slog.String("rule_id", trigger.RuleID),
+			slog.String("user_id", trigger.UserID),
+			slog.String("error", err.Error()))
+	}
+}
+
+// recordShadowResults appends shadow action results to the player's shadow history.
+// Failing to record is logged but does not fail the event, as nothing was executed.
+func (m *Manager) recordShadowResults(ctx context.Context, trigger *rule.Trigger, results []*action.ActionResult, playerCtx *signal.PlayerContext) {
+	if playerCtx == nil || playerCtx.State == nil {
+		return
+	}
+
+	err := service.UpdateChurnStateWithRetry(ctx, m.signalProcessor.GetStateStore(), playerCtx.UserID, playerCtx.State, func(state *service.ChurnState) error {
+		for _, result := range results {
+			description, _ := result.Metadata["would"].(string)
+			state.AddShadowRecord(service.ShadowRecord{
+				RuleID:      trigger.RuleID,
+				ActionID:    result.ActionID,
+				Description: description,
+				RecordedAt:  trigger.Timestamp,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		m.logger.Error("failed to record shadow results",
+			slog.String("rule_id", trigger.RuleID),
+			slog.String("user_id", playerCtx.UserID),
+			slog.String("error", err.Error()))
+	}
+}
+
 // Stats returns pipeline statistics (for observability).
 type Stats struct {
 	ProcessorStats ProcessorStats `json:"processor"`
@@ -196,31 +675,22 @@ type Stats struct {
 	ExecutorStats  ExecutorStats  `json:"executor"`
 }
 
-// ProcessorStats contains signal processor statistics.
-type ProcessorStats struct {
-	TotalEventsProcessed int64 `json:"total_events_processed"`
-	SignalsGenerated     int64 `json:"signals_generated"`
-}
+// ProcessorStats contains signal processor statistics, broken down by event type.
+type ProcessorStats = signal.ProcessorStats
 
-// EngineStats contains rule engine statistics.
-type EngineStats struct {
-	TotalEvaluations  int64 `json:"total_evaluations"`
-	TriggersGenerated int64 `json:"triggers_generated"`
-}
+// EngineStats contains rule engine statistics, broken down by rule ID.
+type EngineStats = rule.EngineStats
 
-// ExecutorStats contains action executor statistics.
-type ExecutorStats struct {
-	TotalActionsExecuted int64 `json:"total_actions_executed"`
-	SuccessfulActions    int64 `json:"successful_actions"`
-	FailedActions        int64 `json:"failed_actions"`
-}
+// ExecutorStats contains action executor statistics, broken down by action ID.
+type ExecutorStats = action.ExecutorStats
 
 // GetStats returns current pipeline statistics.
-// Note: Basic implementation - can be extended with actual metrics tracking.
+// Counters are kept since startup and survive configuration reloads.
 func (m *Manager) GetStats() Stats {
+	active := m.active.Load()
 	return Stats{
-		ProcessorStats: ProcessorStats{},
-		EngineStats:    EngineStats{},
-		ExecutorStats:  ExecutorStats{},
+		ProcessorStats: m.signalProcessor.Stats(),
+		EngineStats:    active.engine.Stats(),
+		ExecutorStats:  active.executor.Stats(),
 	}
 }
@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 	asyncapi_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
 	asyncapi_social "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
@@ -15,6 +19,7 @@ import (
 	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
 	"github.com/alicebob/miniredis/v2"
 	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 // mockRule for testing
@@ -66,6 +71,7 @@ func (m *mockRule) Config() rule.RuleConfig {
 type mockAction struct {
 	id         string
 	shouldFail bool
+	executions int
 }
 
 func (m *mockAction) ID() string {
@@ -77,6 +83,7 @@ func (m *mockAction) Name() string {
 }
 
 func (m *mockAction) Execute(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+	m.executions++
 	if m.shouldFail {
 		return errors.New("mock action failed")
 	}
@@ -112,6 +119,11 @@ func (m *mockStateStore) UpdateChurnState(ctx context.Context, userID string, st
 	return nil
 }
 
+func (m *mockStateStore) DeleteChurnState(ctx context.Context, userID string) error {
+	m.state = nil
+	return nil
+}
+
 // setupTestProcessor creates a processor with builtin event processors registered
 func setupTestProcessor(stateStore service.StateStore) *signal.Processor {
 	processor := signal.NewProcessor(stateStore, "test")
@@ -129,6 +141,8 @@ func setupTestProcessor(stateStore service.StateStore) *signal.Processor {
 		processor.GetNamespace(),
 		&signalBuiltin.EventProcessorDependencies{
 			LoginTrackingStore: loginSessionTracker,
+			StatCycleStore:     service.NewMemoryStatCycleStore(),
+			SessionTracker:     service.NewMemorySessionTracker(),
 		},
 	)
 
@@ -413,3 +427,736 @@ func TestGetStats(t *testing.T) {
 		t.Error("invalid processor stats")
 	}
 }
+
+func TestProcessStatEvent_EventsReceivedMetric(t *testing.T) {
+	processor := setupTestProcessor(&mockStateStore{})
+	engine := rule.NewEngine(rule.NewRegistry())
+	executor := action.NewExecutor(action.NewRegistry())
+	manager := pipeline.NewManager(processor, engine, executor, nil, nil)
+
+	counter := metrics.EventsReceivedTotal.WithLabelValues("other-namespace", "statistic", "metrics-stat")
+	before := testutil.ToFloat64(counter)
+
+	event := &asyncapi_social.StatItemUpdated{
+		Namespace: "other-namespace",
+		UserId:    "test-user",
+		Payload:   &asyncapi_social.StatItem{StatCode: "metrics-stat", UserId: "test-user"},
+	}
+	if err := manager.ProcessStatEvent(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if got := testutil.ToFloat64(counter) - before; got != 1 {
+		t.Errorf("expected 1 received event, got %v", got)
+	}
+}
+
+func TestProcessStatEvent_DuplicateEventDropped(t *testing.T) {
+	ctx := context.Background()
+
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+
+	stateStore := &mockStateStore{
+		state: &service.ChurnState{},
+	}
+	processor := setupTestProcessor(stateStore)
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "test-rule", shouldMatch: true})
+	engine := rule.NewEngine(ruleRegistry)
+
+	grantAction := &mockAction{id: "grant-item"}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(grantAction)
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor, map[string][]string{
+		"test-rule": {"grant-item"},
+	}, nil)
+	manager.SetDeduplicator(service.NewRedisEventDeduplicationStore(redisClient, service.RedisEventDeduplicationStoreConfig{}))
+
+	event := &asyncapi_social.StatItemUpdated{
+		Id:        "event-1",
+		UserId:    "test-user",
+		Namespace: "test",
+		Payload: &asyncapi_social.StatItem{
+			StatCode:    "rse-rage-quit",
+			UserId:      "test-user",
+			LatestValue: 3,
+		},
+	}
+
+	// Simulate Kafka Connect redelivering the same event
+	for i := 0; i < 3; i++ {
+		if err := manager.ProcessStatEvent(ctx, event); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+	}
+
+	if grantAction.executions != 1 {
+		t.Errorf("expected action to execute once, got %d", grantAction.executions)
+	}
+
+	// A different event ID must still be processed
+	event.Id = "event-2"
+	if err := manager.ProcessStatEvent(ctx, event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if grantAction.executions != 2 {
+		t.Errorf("expected action to execute twice, got %d", grantAction.executions)
+	}
+}
+
+func TestProcessStatEvent_LateEventDropped(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	processor := setupTestProcessor(&mockStateStore{state: &service.ChurnState{}})
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "test-rule", shouldMatch: true})
+	engine := rule.NewEngine(ruleRegistry)
+
+	grantAction := &mockAction{id: "grant-item"}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(grantAction)
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor, map[string][]string{
+		"test-rule": {"grant-item"},
+	}, nil)
+	manager.SetClock(clock.NewVirtual(now))
+	manager.SetLatenessWindow(time.Hour)
+
+	process := func(timestamp string) {
+		t.Helper()
+		err := manager.ProcessStatEvent(ctx, &asyncapi_social.StatItemUpdated{
+			UserId:    "test-user",
+			Timestamp: timestamp,
+			Payload: &asyncapi_social.StatItem{
+				StatCode:    "rse-rage-quit",
+				UserId:      "test-user",
+				LatestValue: 3,
+			},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+	}
+
+	// Events within the window, and events without a timestamp, are processed
+	process(now.Add(-30 * time.Minute).Format(time.RFC3339))
+	process("")
+	if grantAction.executions != 2 {
+		t.Fatalf("expected action to execute twice, got %d", grantAction.executions)
+	}
+
+	// An event older than the window is dropped
+	process(now.Add(-2 * time.Hour).Format(time.RFC3339))
+	if grantAction.executions != 2 {
+		t.Errorf("expected late event to be dropped, got %d executions", grantAction.executions)
+	}
+}
+
+func TestProcessStatEvent_FailedEventNotDeduplicated(t *testing.T) {
+	ctx := context.Background()
+
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+
+	processor := setupTestProcessor(&mockStateStore{})
+	engine := rule.NewEngine(rule.NewRegistry())
+	executor := action.NewExecutor(action.NewRegistry())
+
+	manager := pipeline.NewManager(processor, engine, executor, nil, nil)
+	manager.SetDeduplicator(service.NewRedisEventDeduplicationStore(redisClient, service.RedisEventDeduplicationStoreConfig{}))
+
+	// Missing stat code makes signal processing fail
+	event := &asyncapi_social.StatItemUpdated{
+		Id:      "event-1",
+		UserId:  "test-user",
+		Payload: &asyncapi_social.StatItem{UserId: "test-user"},
+	}
+
+	// The redelivery must be processed again (and fail again), not silently dropped
+	for i := 0; i < 2; i++ {
+		if err := manager.ProcessStatEvent(ctx, event); err == nil {
+			t.Fatalf("attempt %d: expected processing error, got nil", i+1)
+		}
+	}
+}
+
+// redeliveringStateStore delivers the event being processed again while loading the
+// player's state, as a concurrent duplicate would, and fails the first load.
+type redeliveringStateStore struct {
+	mockStateStore
+	redeliver   func() error
+	loads       int
+	redelivered error
+}
+
+func (s *redeliveringStateStore) GetChurnState(ctx context.Context, userID string) (*service.ChurnState, error) {
+	s.loads++
+	if s.loads == 1 {
+		s.redelivered = s.redeliver()
+		return nil, errors.New("first delivery failed")
+	}
+	return s.mockStateStore.GetChurnState(ctx, userID)
+}
+
+func TestProcessStatEvent_DuplicateInProgressNotDropped(t *testing.T) {
+	ctx := context.Background()
+
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+
+	stateStore := &redeliveringStateStore{mockStateStore: mockStateStore{state: &service.ChurnState{}}}
+	processor := setupTestProcessor(stateStore)
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "test-rule", shouldMatch: true})
+	engine := rule.NewEngine(ruleRegistry)
+
+	grantAction := &mockAction{id: "grant-item"}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(grantAction)
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor, map[string][]string{
+		"test-rule": {"grant-item"},
+	}, nil)
+	manager.SetDeduplicator(service.NewRedisEventDeduplicationStore(redisClient, service.RedisEventDeduplicationStoreConfig{}))
+
+	event := &asyncapi_social.StatItemUpdated{
+		Id:     "event-1",
+		UserId: "test-user",
+		Payload: &asyncapi_social.StatItem{
+			StatCode:    "rse-rage-quit",
+			UserId:      "test-user",
+			LatestValue: 3,
+		},
+	}
+	stateStore.redeliver = func() error { return manager.ProcessStatEvent(ctx, event) }
+
+	// The first delivery fails while its duplicate arrives
+	if err := manager.ProcessStatEvent(ctx, event); err == nil {
+		t.Fatal("expected the first delivery to fail")
+	}
+	if !errors.Is(stateStore.redelivered, pipeline.ErrEventInProgress) {
+		t.Fatalf("expected the duplicate in progress to fail with ErrEventInProgress, got: %v", stateStore.redelivered)
+	}
+
+	// The duplicate is redelivered and processed, since the first delivery failed
+	if err := manager.ProcessStatEvent(ctx, event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if grantAction.executions != 1 {
+		t.Errorf("expected the redelivered event to execute the action, got %d executions", grantAction.executions)
+	}
+
+	// Once completed, further duplicates are dropped
+	if err := manager.ProcessStatEvent(ctx, event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if grantAction.executions != 1 {
+		t.Errorf("expected the completed event to be dropped, got %d executions", grantAction.executions)
+	}
+}
+
+// stateWritingAction records an intervention in the churn state, then grants an item.
+// Before its first save it simulates a concurrent event writing the same player's state.
+type stateWritingAction struct {
+	id      string
+	store   service.StateStore
+	updates int
+	grants  int
+}
+
+func (a *stateWritingAction) ID() string   { return a.id }
+func (a *stateWritingAction) Name() string { return "State Writing Action" }
+func (a *stateWritingAction) Config() action.ActionConfig {
+	return action.ActionConfig{ID: a.id, Type: "mock", Enabled: true}
+}
+func (a *stateWritingAction) Rollback(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+	return action.ErrRollbackNotSupported
+}
+
+func (a *stateWritingAction) Execute(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+	err := service.UpdateChurnStateWithRetry(ctx, a.store, trigger.UserID, playerCtx.State, func(state *service.ChurnState) error {
+		a.updates++
+		if a.updates == 1 {
+			concurrent, err := a.store.GetChurnState(ctx, trigger.UserID)
+			if err != nil {
+				return err
+			}
+			concurrent.AddIntervention("concurrent", "grant_item", "other-rule", nil, nil, time.Now())
+			if err := a.store.UpdateChurnState(ctx, trigger.UserID, concurrent); err != nil {
+				return err
+			}
+		}
+
+		state.AddIntervention("from-pipeline", "grant_item", trigger.RuleID, nil, nil, time.Now())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	a.grants++
+	return nil
+}
+
+func TestProcessStatEvent_StateConflictReappliesUpdate(t *testing.T) {
+	ctx := namespace.NewContext(context.Background(), "test", "test")
+
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+
+	store := service.NewRedisChurnStateStore(redisClient, service.RedisChurnStateStoreConfig{})
+	processor := setupTestProcessor(store)
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "test-rule", shouldMatch: true})
+	engine := rule.NewEngine(ruleRegistry)
+
+	// An action granting before the conflicting write must not grant again
+	granter := &mockAction{id: "grant"}
+	writer := &stateWritingAction{id: "write-state", store: store}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(granter)
+	actionRegistry.Register(writer)
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor, map[string][]string{
+		"test-rule": {"grant", "write-state"},
+	}, nil)
+
+	event := &asyncapi_social.StatItemUpdated{
+		UserId: "test-user",
+		Payload: &asyncapi_social.StatItem{
+			StatCode:    "rse-rage-quit",
+			UserId:      "test-user",
+			LatestValue: 3,
+		},
+	}
+
+	if err := manager.ProcessStatEvent(ctx, event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if writer.updates != 2 {
+		t.Errorf("expected the state update to be reapplied once after the conflict, got %d updates", writer.updates)
+	}
+	if granter.executions != 1 || writer.grants != 1 {
+		t.Errorf("expected actions to run once, got %d and %d executions", granter.executions, writer.grants)
+	}
+
+	// Neither write may be lost
+	stored, _ := store.GetChurnState(ctx, "test-user")
+	if stored.GetInterventionByID("concurrent") == nil || stored.GetInterventionByID("from-pipeline") == nil {
+		t.Errorf("expected both interventions to be persisted, got %+v", stored.InterventionHistory)
+	}
+}
+
+func TestReload_SwapsRulesAndActions(t *testing.T) {
+	ctx := context.Background()
+
+	processor := setupTestProcessor(&mockStateStore{state: &service.ChurnState{}})
+
+	oldRules := rule.NewRegistry()
+	oldRules.Register(&mockRule{id: "old-rule", shouldMatch: true})
+	oldAction := &mockAction{id: "old-action"}
+	oldActions := action.NewRegistry()
+	oldActions.Register(oldAction)
+
+	manager := pipeline.NewManager(processor, rule.NewEngine(oldRules), action.NewExecutor(oldActions),
+		map[string][]string{"old-rule": {"old-action"}}, nil)
+
+	event := &asyncapi_social.StatItemUpdated{
+		UserId: "test-user",
+		Payload: &asyncapi_social.StatItem{
+			StatCode:    "rse-rage-quit",
+			UserId:      "test-user",
+			LatestValue: 3,
+		},
+	}
+
+	if err := manager.ProcessStatEvent(ctx, event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	newRules := rule.NewRegistry()
+	newRules.Register(&mockRule{id: "new-rule", shouldMatch: true})
+	newAction := &mockAction{id: "new-action"}
+	newActions := action.NewRegistry()
+	newActions.Register(newAction)
+
+	manager.Reload(newRules, newActions, map[string][]string{"new-rule": {"new-action"}})
+
+	if err := manager.ProcessStatEvent(ctx, event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if oldAction.executions != 1 {
+		t.Errorf("expected old action to run only before reload, got %d executions", oldAction.executions)
+	}
+	if newAction.executions != 1 {
+		t.Errorf("expected new action to run after reload, got %d executions", newAction.executions)
+	}
+}
+
+// interventionAction records an intervention of the triggering rule in the churn state.
+type interventionAction struct {
+	mockAction
+	store service.StateStore
+}
+
+func (a *interventionAction) Execute(ctx context.Context, trigger *rule.Trigger, playerCtx *signal.PlayerContext) error {
+	a.executions++
+	return service.UpdateChurnStateWithRetry(ctx, a.store, trigger.UserID, playerCtx.State, func(state *service.ChurnState) error {
+		state.AddIntervention(a.id, "grant_item", trigger.RuleID, nil, nil, time.Now())
+		return nil
+	})
+}
+
+func TestReloadNamespace_RoutesEventsByNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+
+	store := service.NewRedisChurnStateStore(redisClient, service.RedisChurnStateStoreConfig{})
+	processor := setupTestProcessor(store)
+
+	defaultRules := rule.NewRegistry()
+	defaultRules.Register(&mockRule{id: "default-rule", shouldMatch: true})
+	defaultAction := &interventionAction{mockAction: mockAction{id: "default-action"}, store: store}
+	defaultActions := action.NewRegistry()
+	defaultActions.Register(defaultAction)
+
+	manager := pipeline.NewManager(processor, rule.NewEngine(defaultRules), action.NewExecutor(defaultActions),
+		map[string][]string{"default-rule": {"default-action"}}, nil)
+
+	gameRules := rule.NewRegistry()
+	gameRules.Register(&mockRule{id: "game-b-rule", shouldMatch: true})
+	gameAction := &interventionAction{mockAction: mockAction{id: "game-b-action"}, store: store}
+	gameActions := action.NewRegistry()
+	gameActions.Register(gameAction)
+
+	manager.ReloadNamespace("game-b", gameRules, gameActions, map[string][]string{"game-b-rule": {"game-b-action"}})
+
+	rageQuit := func(ns string) *asyncapi_social.StatItemUpdated {
+		return &asyncapi_social.StatItemUpdated{
+			UserId:    "test-user",
+			Namespace: ns,
+			Payload: &asyncapi_social.StatItem{
+				StatCode:    "rse-rage-quit",
+				UserId:      "test-user",
+				LatestValue: 3,
+			},
+		}
+	}
+
+	// game-b is evaluated against its own configuration; the default namespace and
+	// namespaces without their own configuration fall back to the default one
+	for _, ns := range []string{"game-b", "test", "game-c"} {
+		if err := manager.ProcessStatEvent(ctx, rageQuit(ns)); err != nil {
+			t.Fatalf("expected no error for namespace %s, got: %v", ns, err)
+		}
+	}
+
+	if gameAction.executions != 1 {
+		t.Errorf("expected the game-b action to run for game-b only, got %d executions", gameAction.executions)
+	}
+	if defaultAction.executions != 2 {
+		t.Errorf("expected the default action to run for test and game-c, got %d executions", defaultAction.executions)
+	}
+
+	// The same user ID is a separate player in each namespace
+	for _, key := range []string{
+		"churn_intervention:user_state:test-user",
+		"churn_intervention:user_state:game-b:test-user",
+		"churn_intervention:user_state:game-c:test-user",
+	} {
+		if !mr.Exists(key) {
+			t.Errorf("expected state key %s, got keys %v", key, mr.Keys())
+		}
+	}
+	for ns, ruleID := range map[string]string{"test": "default-rule", "game-b": "game-b-rule"} {
+		state, err := store.GetChurnState(namespace.NewContext(ctx, ns, "test"), "test-user")
+		if err != nil {
+			t.Fatalf("GetChurnState() error = %v", err)
+		}
+		if len(state.InterventionHistory) != 1 || state.InterventionHistory[0].TriggeredBy != ruleID {
+			t.Errorf("expected one %s intervention in namespace %s, got %+v", ruleID, ns, state.InterventionHistory)
+		}
+	}
+}
+
+func TestProcessStatEvent_ShadowActionRecorded(t *testing.T) {
+	ctx := context.Background()
+
+	stateStore := &mockStateStore{state: &service.ChurnState{}}
+	processor := setupTestProcessor(stateStore)
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "test-rule", shouldMatch: true})
+
+	shadowAction := &shadowMockAction{mockAction{id: "shadow-action"}}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(shadowAction)
+
+	manager := pipeline.NewManager(processor, rule.NewEngine(ruleRegistry), action.NewExecutor(actionRegistry),
+		map[string][]string{"test-rule": {"shadow-action"}}, nil)
+
+	event := &asyncapi_social.StatItemUpdated{
+		UserId: "test-user",
+		Payload: &asyncapi_social.StatItem{
+			StatCode:    "rse-rage-quit",
+			UserId:      "test-user",
+			LatestValue: 3,
+		},
+	}
+
+	if err := manager.ProcessStatEvent(ctx, event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if shadowAction.executions != 0 {
+		t.Errorf("expected shadow action not to be executed, got %d executions", shadowAction.executions)
+	}
+
+	if len(stateStore.state.InterventionHistory) != 0 {
+		t.Errorf("expected no interventions, got %+v", stateStore.state.InterventionHistory)
+	}
+	if len(stateStore.state.ShadowHistory) != 1 || stateStore.state.ShadowHistory[0].ActionID != "shadow-action" {
+		t.Errorf("expected shadow action to be recorded, got %+v", stateStore.state.ShadowHistory)
+	}
+}
+
+// shadowMockAction is a mockAction configured in shadow mode
+type shadowMockAction struct {
+	mockAction
+}
+
+func (m *shadowMockAction) Config() action.ActionConfig {
+	config := m.mockAction.Config()
+	config.Mode = rule.ModeShadow
+	return config
+}
+
+func TestGetStats_CountsPipelineActivity(t *testing.T) {
+	processor := setupTestProcessor(&mockStateStore{state: &service.ChurnState{}})
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "test-rule", shouldMatch: true})
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(&mockAction{id: "test-action"})
+
+	manager := pipeline.NewManager(processor, rule.NewEngine(ruleRegistry), action.NewExecutor(actionRegistry),
+		map[string][]string{"test-rule": {"test-action"}}, nil)
+
+	event := &asyncapi_social.StatItemUpdated{
+		UserId: "test-user",
+		Payload: &asyncapi_social.StatItem{
+			StatCode:    "rse-rage-quit",
+			UserId:      "test-user",
+			LatestValue: 3,
+		},
+	}
+	if err := manager.ProcessStatEvent(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	stats := manager.GetStats()
+	if stats.ProcessorStats.ByEventType["rse-rage-quit"].SignalsGenerated != 1 {
+		t.Errorf("expected one signal for rse-rage-quit, got %+v", stats.ProcessorStats)
+	}
+	if stats.EngineStats.ByRule["test-rule"].Triggers != 1 {
+		t.Errorf("expected one trigger for test-rule, got %+v", stats.EngineStats)
+	}
+	if stats.ExecutorStats.ByAction["test-action"].Successes != 1 {
+		t.Errorf("expected one successful test-action, got %+v", stats.ExecutorStats)
+	}
+}
+
+// cooldownRule triggers on every losing streak signal, at most once per cooldown
+type cooldownRule struct {
+	mockRule
+}
+
+func (r *cooldownRule) SignalTypes() []string {
+	return []string{signalBuiltin.TypeLosingStreak}
+}
+
+func (r *cooldownRule) Config() rule.RuleConfig {
+	config := r.mockRule.Config()
+	config.Cooldown = &rule.CooldownConfig{Duration: time.Hour, Scope: rule.CooldownScopePerUser}
+	return config
+}
+
+func TestProcessEvent_StatResetClearsCooldowns(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+
+	processor := setupTestProcessor(&mockStateStore{})
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&cooldownRule{mockRule{id: "losing-streak", shouldMatch: true}})
+	engine := rule.NewEngine(ruleRegistry)
+	engine.SetCooldownStore(service.NewRedisRuleCooldownStore(redis.NewClient(&redis.Options{Addr: mr.Addr()})))
+
+	mockAction := &mockAction{id: "dispatch-comeback-challenge"}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(mockAction)
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor,
+		map[string][]string{"losing-streak": {"dispatch-comeback-challenge"}}, nil)
+
+	streak := &asyncapi_social.StatItemUpdated{
+		UserId:  "test-user",
+		Payload: &asyncapi_social.StatItem{StatCode: "rse-current-losing-streak", LatestValue: 6},
+	}
+	for i := 0; i < 2; i++ {
+		if err := manager.ProcessStatEvent(ctx, streak); err != nil {
+			t.Fatalf("ProcessStatEvent() error = %v", err)
+		}
+	}
+	if mockAction.executions != 1 {
+		t.Fatalf("expected the cooldown to allow 1 execution, got %d", mockAction.executions)
+	}
+
+	deleted := &asyncapi_social.StatItemDeleted{
+		UserId:  "test-user",
+		Payload: &asyncapi_social.StatItem{StatCode: "rse-current-losing-streak"},
+	}
+	if err := manager.ProcessEvent(ctx, signalBuiltin.EventTypeStatItemDeleted, deleted); err != nil {
+		t.Fatalf("ProcessEvent() error = %v", err)
+	}
+
+	if err := manager.ProcessStatEvent(ctx, streak); err != nil {
+		t.Fatalf("ProcessStatEvent() error = %v", err)
+	}
+	if mockAction.executions != 2 {
+		t.Errorf("expected the stat reset to clear the cooldown, got %d executions", mockAction.executions)
+	}
+}
+
+// cycleRule triggers on every update of a stat cycle
+type cycleRule struct {
+	mockRule
+}
+
+func (r *cycleRule) SignalTypes() []string {
+	return []string{signalBuiltin.TypeStatCycleUpdated}
+}
+
+func TestProcessEvent_StatCycleReset(t *testing.T) {
+	ctx := namespace.NewContext(context.Background(), "test", "test")
+
+	stateStore := service.NewMemoryChurnStateStore()
+	state, _ := stateStore.GetChurnState(ctx, "test-user")
+	state.AddSignal(signalBuiltin.TypeLosingStreak, "high", nil, time.Now())
+	state.AddSignal(signalBuiltin.TypeRageQuit, "high", nil, time.Now())
+	if err := stateStore.UpdateChurnState(ctx, "test-user", state); err != nil {
+		t.Fatalf("UpdateChurnState() error = %v", err)
+	}
+
+	processor := setupTestProcessor(stateStore)
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&cycleRule{mockRule{id: "season-streak", shouldMatch: true}})
+	mockAction := &mockAction{id: "dispatch-comeback-challenge"}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(mockAction)
+
+	manager := pipeline.NewManager(processor, rule.NewEngine(ruleRegistry), action.NewExecutor(actionRegistry),
+		map[string][]string{"season-streak": {"dispatch-comeback-challenge"}}, nil)
+
+	update := func(version int64) {
+		t.Helper()
+		event := &asyncapi_social.StatItemCycleUpdated{
+			UserId: "test-user",
+			Payload: &asyncapi_social.StatCycleItem{
+				StatCode:     "rse-current-losing-streak",
+				CycleId:      "season",
+				CycleVersion: version,
+				LatestValue:  1,
+			},
+		}
+		if err := manager.ProcessEvent(ctx, signalBuiltin.EventTypeStatItemCycleUpdated, event); err != nil {
+			t.Fatalf("ProcessEvent() error = %v", err)
+		}
+	}
+
+	update(1)
+	update(2)
+
+	// The first update of the new cycle resets the stat and is evaluated too
+	if mockAction.executions != 2 {
+		t.Errorf("expected both updates to be evaluated, got %d executions", mockAction.executions)
+	}
+
+	stored, _ := stateStore.GetChurnState(ctx, "test-user")
+	if _, ok := stored.Cooldown.LastSignalAt[signalBuiltin.TypeLosingStreak]; ok || len(stored.SignalHistory) != 1 {
+		t.Errorf("expected the losing streak signals to be cleared, got %+v", stored.SignalHistory)
+	}
+	if _, ok := stored.Cooldown.LastSignalAt[signalBuiltin.TypeRageQuit]; !ok {
+		t.Error("expected the signals of other stats to be kept")
+	}
+}
+
+func TestProcessStatEvent_ActionFailureReleasesCooldown(t *testing.T) {
+	ctx := context.Background()
+
+	processor := setupTestProcessor(&mockStateStore{})
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&cooldownRule{mockRule{id: "losing-streak", shouldMatch: true}})
+	engine := rule.NewEngine(ruleRegistry)
+
+	failingAction := &mockAction{id: "dispatch-comeback-challenge", shouldFail: true}
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(failingAction)
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor,
+		map[string][]string{"losing-streak": {"dispatch-comeback-challenge"}}, nil)
+
+	streak := &asyncapi_social.StatItemUpdated{
+		UserId:  "test-user",
+		Payload: &asyncapi_social.StatItem{StatCode: "rse-current-losing-streak", LatestValue: 6},
+	}
+
+	// The failed intervention is not suppressed by the cooldown it started
+	if err := manager.ProcessStatEvent(ctx, streak); err != nil {
+		t.Fatalf("ProcessStatEvent() error = %v", err)
+	}
+	failingAction.shouldFail = false
+	if err := manager.ProcessStatEvent(ctx, streak); err != nil {
+		t.Fatalf("ProcessStatEvent() error = %v", err)
+	}
+	if failingAction.executions != 2 {
+		t.Fatalf("expected the failure to release the cooldown, got %d executions", failingAction.executions)
+	}
+
+	// A successful intervention keeps its cooldown
+	if err := manager.ProcessStatEvent(ctx, streak); err != nil {
+		t.Fatalf("ProcessStatEvent() error = %v", err)
+	}
+	if failingAction.executions != 2 {
+		t.Errorf("expected the cooldown to suppress the trigger, got %d executions", failingAction.executions)
+	}
+}
@@ -0,0 +1,138 @@
+package pipeline
+
+import (
+	"sort"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
+	rulepkg "github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+)
+
+// JSONSchema returns a JSON Schema (draft-07) of pipeline.yaml for editor validation and
+// autocompletion. Rule and action types are limited to the registered ones, and the
+// parameters of each entry are described by the schema its type registered.
+func JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"$schema":              "http://json-schema.org/draft-07/schema#",
+		"title":                "Churn intervention pipeline configuration",
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"signals": map[string]interface{}{"type": "array", "items": map[string]interface{}{"$ref": "#/definitions/signal"}},
+			"rules":   map[string]interface{}{"type": "array", "items": map[string]interface{}{"$ref": "#/definitions/rule"}},
+			"actions": map[string]interface{}{"type": "array", "items": map[string]interface{}{"$ref": "#/definitions/action"}},
+		},
+		"definitions": map[string]interface{}{
+			"signal": signalJSONSchema(),
+			"rule":   ruleJSONSchema(),
+			"action": actionJSONSchema(),
+		},
+	}
+}
+
+func signalJSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"required":             []string{"stat_code", "type"},
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"stat_code": map[string]interface{}{"type": "string", "description": "Stat code whose updates are mapped"},
+			"type":      map[string]interface{}{"type": "string", "description": "Signal type rules match on"},
+			"value": map[string]interface{}{
+				"enum":        []string{signal.StatValueLatest, signal.StatValueInc},
+				"description": "Stat item field used as the signal value",
+			},
+			"metadata": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "additional_data fields copied into the signal metadata",
+			},
+		},
+	}
+}
+
+func ruleJSONSchema() map[string]interface{} {
+	schemas := rulepkg.Schemas()
+	return entryJSONSchema(schemas, map[string]interface{}{
+		"actions": map[string]interface{}{
+			"type":        "array",
+			"items":       map[string]interface{}{"type": "string"},
+			"description": "IDs of the actions to execute when the rule triggers",
+		},
+		"cooldown": map[string]interface{}{
+			"type":                 "object",
+			"description":          "Minimum time between triggers",
+			"additionalProperties": false,
+			"required":             []string{"duration"},
+			"properties": map[string]interface{}{
+				"duration": map[string]interface{}{"type": "string", "description": "Go duration, e.g. 24h"},
+				"scope":    map[string]interface{}{"enum": []string{"per_user", "global"}},
+			},
+		},
+		"conditions": map[string]interface{}{
+			"type":                 "object",
+			"description":          "Named CEL expressions that must all hold for the rule to trigger",
+			"additionalProperties": map[string]interface{}{"type": "string"},
+		},
+	})
+}
+
+func actionJSONSchema() map[string]interface{} {
+	schemas := action.Schemas()
+	return entryJSONSchema(schemas, map[string]interface{}{
+		"async": map[string]interface{}{"type": "boolean", "description": "Execute off the request path"},
+		"retry": map[string]interface{}{
+			"type":                 "object",
+			"description":          "Retry policy for transient failures",
+			"additionalProperties": false,
+			"required":             []string{"max_attempts"},
+			"properties": map[string]interface{}{
+				"max_attempts": map[string]interface{}{"type": "integer", "minimum": 1},
+				"delay":        map[string]interface{}{"type": "string", "description": "Go duration, e.g. 500ms"},
+				"backoff":      map[string]interface{}{"enum": []string{"constant", "linear", "exponential"}},
+			},
+		},
+	})
+}
+
+// entryJSONSchema describes a rule or action entry: the common fields, the fields given,
+// and the parameters of each type.
+func entryJSONSchema(schemas map[string]param.Schema, fields map[string]interface{}) map[string]interface{} {
+	types := make([]string, 0, len(schemas))
+	for entryType := range schemas {
+		types = append(types, entryType)
+	}
+	sort.Strings(types)
+
+	properties := map[string]interface{}{
+		"id":         map[string]interface{}{"type": "string"},
+		"type":       map[string]interface{}{"enum": types},
+		"enabled":    map[string]interface{}{"type": "boolean"},
+		"mode":       map[string]interface{}{"enum": []string{rulepkg.ModeLive, rulepkg.ModeShadow}},
+		"parameters": map[string]interface{}{"type": "object"},
+	}
+	for name, field := range fields {
+		properties[name] = field
+	}
+
+	parameters := make([]interface{}, 0, len(types))
+	for _, entryType := range types {
+		parameters = append(parameters, map[string]interface{}{
+			"if": map[string]interface{}{
+				"properties": map[string]interface{}{"type": map[string]interface{}{"const": entryType}},
+			},
+			"then": map[string]interface{}{
+				"properties": map[string]interface{}{"parameters": schemas[entryType].JSONSchema()},
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"required":             []string{"id", "type"},
+		"additionalProperties": false,
+		"properties":           properties,
+		"allOf":                parameters,
+	}
+}
@@ -0,0 +1,62 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer creates the root span of each processed event using the global tracer provider.
+// Signal processing, rule evaluation and action execution add child spans to it.
+var tracer = otel.Tracer("github.com/AccelByte/extend-churn-intervention/pkg/pipeline")
+
+// agsTraceIDAttribute holds the trace ID AGS attached to an event.
+const agsTraceIDAttribute = "ags.trace_id"
+
+// startEventSpan starts the span covering an event's trip through the pipeline.
+// The span is a child of any span already in ctx (e.g. the gRPC server span).
+// Events carrying an AGS trace ID record it as an attribute and, when it is a valid
+// W3C trace ID (dashes are ignored), link to it so the upstream trace can be followed.
+func startEventSpan(ctx context.Context, eventType string, event interface{}) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("event.type", eventType),
+		attribute.String("event.id", getEventID(event)),
+		attribute.String("user.id", getEventUserID(event)),
+	}
+	opts := []trace.SpanStartOption{trace.WithSpanKind(trace.SpanKindConsumer)}
+
+	if agsTraceID := getEventTraceID(event); agsTraceID != "" {
+		attrs = append(attrs, attribute.String(agsTraceIDAttribute, agsTraceID))
+
+		if traceID, err := trace.TraceIDFromHex(strings.ReplaceAll(agsTraceID, "-", "")); err == nil {
+			opts = append(opts, trace.WithLinks(trace.Link{
+				SpanContext: trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, Remote: true}),
+				Attributes:  []attribute.KeyValue{attribute.String(agsTraceIDAttribute, agsTraceID)},
+			}))
+		}
+	}
+
+	opts = append(opts, trace.WithAttributes(attrs...))
+	return tracer.Start(ctx, "pipeline.process_event", opts...)
+}
+
+// endEventSpan records the outcome of processing an event and ends its span.
+func endEventSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// getEventTraceID returns the AGS trace ID of an event, if it carries one.
+func getEventTraceID(event interface{}) string {
+	if e, ok := event.(interface{ GetTraceId() string }); ok {
+		return e.GetTraceId()
+	}
+	return ""
+}
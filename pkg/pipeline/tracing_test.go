@@ -0,0 +1,83 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/action"
+	asyncapi_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/pipeline"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestProcessOAuthEvent_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	processor := setupTestProcessor(&mockStateStore{state: &service.ChurnState{}})
+
+	ruleRegistry := rule.NewRegistry()
+	ruleRegistry.Register(&mockRule{id: "traced-rule", shouldMatch: true})
+	engine := rule.NewEngine(ruleRegistry)
+
+	actionRegistry := action.NewRegistry()
+	actionRegistry.Register(&mockAction{id: "traced-action"})
+	executor := action.NewExecutor(actionRegistry)
+
+	manager := pipeline.NewManager(processor, engine, executor, map[string][]string{
+		"traced-rule": {"traced-action"},
+	}, nil)
+
+	const agsTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	event := &asyncapi_iam.OauthTokenGenerated{
+		UserId:    "test-user",
+		Namespace: "test",
+		TraceId:   agsTraceID,
+	}
+	if err := manager.ProcessOAuthEvent(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	root, ok := spans["pipeline.process_event"]
+	if !ok {
+		t.Fatalf("expected pipeline.process_event span, got %v", spans)
+	}
+	for _, name := range []string{"signal.process", "rule.evaluate", "action.execute"} {
+		span, ok := spans[name]
+		if !ok {
+			t.Errorf("expected %s span", name)
+			continue
+		}
+		if span.SpanContext().TraceID() != root.SpanContext().TraceID() {
+			t.Errorf("expected %s span in the event's trace", name)
+		}
+	}
+
+	if links := root.Links(); len(links) != 1 || links[0].SpanContext.TraceID().String() != agsTraceID {
+		t.Errorf("expected a link to AGS trace %s, got %+v", agsTraceID, links)
+	}
+	if span, ok := spans["action.execute"]; ok && !hasAttribute(span.Attributes(), attribute.String("rule.id", "traced-rule")) {
+		t.Errorf("expected action span to carry the rule ID, got %v", span.Attributes())
+	}
+}
+
+func hasAttribute(attrs []attribute.KeyValue, want attribute.KeyValue) bool {
+	for _, attr := range attrs {
+		if attr == want {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
@@ -14,6 +15,12 @@ import (
 	"github.com/go-redis/redis/v8"
 )
 
+// testContext returns a context scoped to the test namespace, as the pipeline scopes the
+// context of each event.
+func testContext() context.Context {
+	return namespace.NewContext(context.Background(), "test-namespace", "test-namespace")
+}
+
 func TestRageQuitRule_Evaluate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -64,7 +71,7 @@ func TestRageQuitRule_Evaluate(t *testing.T) {
 			}
 			sig := signalBuiltin.NewRageQuitSignal("test-user", time.Now(), int(tt.statValue), playerCtx)
 
-			matched, trigger, err := rule.Evaluate(context.Background(), sig)
+			matched, trigger, err := rule.Evaluate(testContext(), sig)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
 			}
@@ -108,7 +115,7 @@ func TestRageQuitRule_WrongSignalType(t *testing.T) {
 	}
 	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
 
-	matched, trigger, err := rule.Evaluate(context.Background(), sig)
+	matched, trigger, err := rule.Evaluate(testContext(), sig)
 	if err == nil {
 		t.Error("Expected error for wrong signal type")
 	}
@@ -170,7 +177,7 @@ func TestLosingStreakRule_Evaluate(t *testing.T) {
 			}
 			sig := signalBuiltin.NewLosingStreakSignal("test-user", time.Now(), int(tt.statValue), playerCtx)
 
-			matched, trigger, err := rule.Evaluate(context.Background(), sig)
+			matched, trigger, err := rule.Evaluate(testContext(), sig)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
 			}
@@ -217,6 +224,7 @@ func TestSessionDeclineRule_Evaluate(t *testing.T) {
 	tests := []struct {
 		name                string
 		loginCountData      map[string]int // yearWeek -> count
+		parameters          map[string]interface{}
 		cooldownState       service.CooldownState
 		interventionHistory []service.InterventionRecord
 		expectTrigger       bool
@@ -321,19 +329,52 @@ func TestSessionDeclineRule_Evaluate(t *testing.T) {
 				lastWeek:      2,  // Very low
 				// currentWeek: 0 (churned)
 			},
+			parameters:          map[string]interface{}{"min_sessions_last_week": 2},
 			cooldownState:       service.CooldownState{},
 			interventionHistory: []service.InterventionRecord{},
 			expectTrigger:       true, // Detect gradual churn pattern
 		},
+		{
+			name: "too few sessions last week",
+			loginCountData: map[string]int{
+				twoWeeksAgo: 5,
+				lastWeek:    2, // Below the default of 3 sessions
+			},
+			cooldownState:       service.CooldownState{},
+			interventionHistory: []service.InterventionRecord{},
+			expectTrigger:       false,
+		},
+		{
+			name: "decline at threshold",
+			loginCountData: map[string]int{
+				lastWeek:    6,
+				currentWeek: 3, // Halved
+			},
+			cooldownState:       service.CooldownState{},
+			interventionHistory: []service.InterventionRecord{},
+			expectTrigger:       true,
+		},
+		{
+			name: "decline below configured threshold",
+			loginCountData: map[string]int{
+				lastWeek:    6,
+				currentWeek: 3,
+			},
+			parameters:          map[string]interface{}{"decline_threshold": 0.75},
+			cooldownState:       service.CooldownState{},
+			interventionHistory: []service.InterventionRecord{},
+			expectTrigger:       false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			config := rule.RuleConfig{
-				ID:       "test_session_decline",
-				Type:     SessionDeclineRuleID,
-				Enabled:  true,
-				Priority: 10,
+				ID:         "test_session_decline",
+				Type:       SessionDeclineRuleID,
+				Enabled:    true,
+				Priority:   10,
+				Parameters: tt.parameters,
 			}
 
 			mr, _ := miniredis.Run()
@@ -349,7 +390,7 @@ func TestSessionDeclineRule_Evaluate(t *testing.T) {
 				sessionData := &service.SessionTrackingData{
 					LoginCount: tt.loginCountData,
 				}
-				sessionTracker.SaveSessionData(context.Background(), "test-user", sessionData)
+				sessionTracker.SaveSessionData(testContext(), "test-user", sessionData)
 			}
 
 			rule := NewSessionDeclineRule(config, sessionTracker)
@@ -366,7 +407,7 @@ func TestSessionDeclineRule_Evaluate(t *testing.T) {
 			}
 			sig := signalBuiltin.NewLoginSignal("test-user", now, playerCtx)
 
-			matched, trigger, err := rule.Evaluate(context.Background(), sig)
+			matched, trigger, err := rule.Evaluate(testContext(), sig)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
 			}
@@ -409,7 +450,7 @@ func TestSessionDeclineRule_NoPlayerContext(t *testing.T) {
 	// Create signal without player context
 	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), nil)
 
-	matched, trigger, err := rule.Evaluate(context.Background(), sig)
+	matched, trigger, err := rule.Evaluate(testContext(), sig)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -421,3 +462,96 @@ func TestSessionDeclineRule_NoPlayerContext(t *testing.T) {
 		t.Error("Expected no trigger without player context")
 	}
 }
+
+func TestThresholdRule_Evaluate(t *testing.T) {
+	abandoned := func(value float64, extra map[string]interface{}) signal.Signal {
+		sig := signal.NewStatUpdateSignal("user123", time.Now(), "rse-match-abandoned", value, nil)
+		for k, v := range extra {
+			sig.Metadata()[k] = v
+		}
+		return sig
+	}
+
+	tests := []struct {
+		name          string
+		parameters    map[string]interface{}
+		signal        signal.Signal
+		expectTrigger bool
+		expectErr     bool
+	}{
+		{
+			name:          "value at threshold",
+			parameters:    map[string]interface{}{"threshold": 3},
+			signal:        abandoned(3, nil),
+			expectTrigger: true,
+		},
+		{
+			name:       "value below threshold",
+			parameters: map[string]interface{}{"threshold": 3},
+			signal:     abandoned(2, nil),
+		},
+		{
+			name:          "less than",
+			parameters:    map[string]interface{}{"threshold": 0.5, "operator": "lt"},
+			signal:        abandoned(0.25, nil),
+			expectTrigger: true,
+		},
+		{
+			name:          "metadata field",
+			parameters:    map[string]interface{}{"threshold": 10, "field": "duration_seconds", "operator": "lte"},
+			signal:        abandoned(1, map[string]interface{}{"duration_seconds": 8.0}),
+			expectTrigger: true,
+		},
+		{
+			name:       "missing field",
+			parameters: map[string]interface{}{"threshold": 10, "field": "duration_seconds"},
+			signal:     abandoned(100, nil),
+		},
+		{
+			name:       "non-numeric field",
+			parameters: map[string]interface{}{"threshold": 10, "field": "game_mode"},
+			signal:     abandoned(1, map[string]interface{}{"game_mode": "ranked"}),
+			expectErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.parameters["signal_type"] = signal.TypeStatUpdate
+			thresholdRule, err := NewThresholdRule(rule.RuleConfig{
+				ID:         "abandoned",
+				Type:       ThresholdRuleID,
+				Enabled:    true,
+				Parameters: tt.parameters,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if types := thresholdRule.SignalTypes(); len(types) != 1 || types[0] != signal.TypeStatUpdate {
+				t.Errorf("expected the configured signal type, got %v", types)
+			}
+
+			triggered, trigger, err := thresholdRule.Evaluate(testContext(), tt.signal)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+			if triggered != tt.expectTrigger {
+				t.Fatalf("expected trigger %v, got %v", tt.expectTrigger, triggered)
+			}
+			if triggered && trigger.Metadata["stat_code"] != "rse-match-abandoned" {
+				t.Errorf("expected stat code in trigger metadata, got %v", trigger.Metadata)
+			}
+		})
+	}
+}
+
+func TestThresholdRule_UnknownOperator(t *testing.T) {
+	_, err := NewThresholdRule(rule.RuleConfig{
+		ID:         "abandoned",
+		Parameters: map[string]interface{}{"signal_type": "match_abandoned", "threshold": 3, "operator": ">="},
+	})
+	if err == nil {
+		t.Error("expected an error for an unknown operator")
+	}
+}
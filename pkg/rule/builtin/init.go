@@ -12,15 +12,23 @@ type Dependencies struct {
 
 // RegisterRules registers all built-in rule types with the factory.
 func RegisterRules(deps *Dependencies) {
-	rule.RegisterRuleType(RageQuitRuleID, func(config rule.RuleConfig) (rule.Rule, error) {
+	rule.RegisterRuleTypeWithSchema(RageQuitRuleID, RageQuitParams, func(config rule.RuleConfig) (rule.Rule, error) {
 		return NewRageQuitRule(config), nil
 	})
 
-	rule.RegisterRuleType(LosingStreakRuleID, func(config rule.RuleConfig) (rule.Rule, error) {
+	rule.RegisterRuleTypeWithSchema(LosingStreakRuleID, LosingStreakParams, func(config rule.RuleConfig) (rule.Rule, error) {
 		return NewLosingStreakRule(config), nil
 	})
 
-	rule.RegisterRuleType(SessionDeclineRuleID, func(config rule.RuleConfig) (rule.Rule, error) {
+	rule.RegisterRuleTypeWithSchema(SessionDeclineRuleID, SessionDeclineParams, func(config rule.RuleConfig) (rule.Rule, error) {
 		return NewSessionDeclineRule(config, deps.LoginSessionTracker), nil
 	})
+
+	rule.RegisterRuleTypeWithSchema(ThresholdRuleID, ThresholdParams, func(config rule.RuleConfig) (rule.Rule, error) {
+		thresholdRule, err := NewThresholdRule(config)
+		if err != nil {
+			return nil, err
+		}
+		return thresholdRule, nil
+	})
 }
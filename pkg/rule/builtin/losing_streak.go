@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
@@ -18,6 +19,12 @@ const (
 	DefaultLosingStreakThreshold = 5
 )
 
+// LosingStreakParams is the parameter schema of the losing streak rule.
+var LosingStreakParams = param.Schema{
+	{Name: "threshold", Type: param.TypeInt, Default: DefaultLosingStreakThreshold, Min: param.Bound(1),
+		Description: "Consecutive losses (rse-current-losing-streak) at which the rule triggers"},
+}
+
 // LosingStreakRule detects when a player is on a losing streak.
 // A losing streak is tracked via the "rse-current-losing-streak" stat code.
 type LosingStreakRule struct {
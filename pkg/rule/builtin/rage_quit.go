@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
@@ -18,6 +19,12 @@ const (
 	DefaultRageQuitThreshold = 3
 )
 
+// RageQuitParams is the parameter schema of the rage quit rule.
+var RageQuitParams = param.Schema{
+	{Name: "threshold", Type: param.TypeInt, Default: DefaultRageQuitThreshold, Min: param.Bound(1),
+		Description: "Rage quit count (rse-rage-quit) at which the rule triggers"},
+}
+
 // RageQuitRule detects when a player exhibits rage quit behavior.
 // A rage quit is tracked via the "rse-rage-quit" stat code.
 type RageQuitRule struct {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
@@ -15,21 +16,43 @@ import (
 const (
 	// SessionDeclineRuleID is the identifier for session decline detection rule
 	SessionDeclineRuleID = "session_decline"
+
+	// DefaultDeclineThreshold is the default fraction by which weekly sessions must drop
+	DefaultDeclineThreshold = 0.5
+	// DefaultMinSessionsLastWeek is the default number of sessions needed in the last active week
+	DefaultMinSessionsLastWeek = 3
 )
 
+// SessionDeclineParams is the parameter schema of the session decline rule.
+var SessionDeclineParams = param.Schema{
+	{Name: "decline_threshold", Type: param.TypeNumber, Default: DefaultDeclineThreshold, Min: param.Bound(0), Max: param.Bound(1),
+		Description: "Fraction by which this week's sessions must drop from the last active week (1 = no sessions this week)"},
+	{Name: "min_sessions_last_week", Type: param.TypeInt, Default: DefaultMinSessionsLastWeek, Min: param.Bound(0),
+		Description: "Sessions needed in the last active week before this one for a drop to count"},
+}
+
 // SessionDeclineRule detects when a player's session frequency declines week-over-week.
 // This rule uses LoginSessionTracker to access session tracking data.
 type SessionDeclineRule struct {
-	config         rule.RuleConfig
-	sessionTracker service.LoginSessionTracker
+	config              rule.RuleConfig
+	sessionTracker      service.LoginSessionTracker
+	declineThreshold    float64
+	minSessionsLastWeek int
 }
 
 // NewSessionDeclineRule creates a new session decline detection rule.
 func NewSessionDeclineRule(config rule.RuleConfig, sessionTracker service.LoginSessionTracker) *SessionDeclineRule {
-	logrus.Infof("creating session decline rule")
+	declineThreshold := config.GetFloat("decline_threshold", DefaultDeclineThreshold)
+	minSessionsLastWeek := config.GetInt("min_sessions_last_week", DefaultMinSessionsLastWeek)
+
+	logrus.Infof("creating session decline rule with declineThreshold=%.2f, minSessionsLastWeek=%d",
+		declineThreshold, minSessionsLastWeek)
+
 	return &SessionDeclineRule{
-		config:         config,
-		sessionTracker: sessionTracker,
+		config:              config,
+		sessionTracker:      sessionTracker,
+		declineThreshold:    declineThreshold,
+		minSessionsLastWeek: minSessionsLastWeek,
 	}
 }
 
@@ -73,19 +96,19 @@ func (r *SessionDeclineRule) Evaluate(ctx context.Context, sig signal.Signal) (b
 	}
 
 	// Check if player is churning using the map-based data
-	churning := isChurning(sessionData, now)
+	decline, activeWeek, churning := r.isChurning(sessionData, now)
 	if !churning {
 		return false, nil, nil
 	}
 
 	// Check if intervention can be triggered (cooldown check)
-	if playerState.Cooldown.IsOnCooldown() {
+	if playerState.Cooldown.IsOnCooldown(now) {
 		logrus.Debugf("session decline detected for user %s but intervention in cooldown", sig.UserID())
 		return false, nil, nil
 	}
 
 	// Check if there's already an active comeback challenge intervention
-	activeInterventions := playerState.GetActiveInterventions()
+	activeInterventions := playerState.GetActiveInterventions(now)
 	for _, intervention := range activeInterventions {
 		if intervention.Type == "dispatch_comeback_challenge" {
 			logrus.Debugf("session decline detected for user %s but comeback challenge already active", sig.UserID())
@@ -102,9 +125,12 @@ func (r *SessionDeclineRule) Evaluate(ctx context.Context, sig signal.Signal) (b
 	trigger.Metadata["previous_week"] = previousWeek
 	trigger.Metadata["current_week_sessions"] = sessionData.LoginCount[currentWeek]
 	trigger.Metadata["previous_week_sessions"] = sessionData.LoginCount[previousWeek]
+	trigger.Metadata["last_active_week"] = activeWeek
+	trigger.Metadata["decline"] = decline
+	trigger.Metadata["decline_threshold"] = r.declineThreshold
 
-	logrus.Infof("session decline rule triggered for user %s: currentWeek=%s (%d), previousWeek=%s (%d)",
-		sig.UserID(), currentWeek, sessionData.LoginCount[currentWeek], previousWeek, sessionData.LoginCount[previousWeek])
+	logrus.Infof("session decline rule triggered for user %s: currentWeek=%s (%d), lastActiveWeek=%s (%d), decline=%.2f",
+		sig.UserID(), currentWeek, sessionData.LoginCount[currentWeek], activeWeek, sessionData.LoginCount[activeWeek], decline)
 
 	return true, trigger, nil
 }
@@ -116,41 +142,39 @@ func getYearWeek(t time.Time) string {
 }
 
 // isChurning determines if a player is exhibiting churn behavior using map-based data.
+// The current week is compared with the player's last active week before it: last week,
+// or an earlier week for players already absent last week (the tracker keeps 4 weeks).
 // A player is churning if:
-// - They had activity in a recent past week (within last 2 weeks)
-// - They have no activity in the current week (or very low activity)
+// - They had at least minSessionsLastWeek sessions in their last active week
+// - This week's sessions dropped from it by at least declineThreshold
 //
-// This approach handles multi-week absences naturally by checking the map for any recent activity.
-func isChurning(data *service.SessionTrackingData, now time.Time) bool {
-	if len(data.LoginCount) == 0 {
-		return false // No data, can't determine churn
-	}
-
+// Returns the decline (0 to 1) and the last active week.
+func (r *SessionDeclineRule) isChurning(data *service.SessionTrackingData, now time.Time) (float64, string, bool) {
 	currentWeek := getYearWeek(now)
-	previousWeek := getYearWeek(now.Add(-7 * 24 * time.Hour))
 
-	currentCount := data.LoginCount[currentWeek]
-	previousCount := data.LoginCount[previousWeek]
+	// Year-week keys are zero-padded, so they sort chronologically
+	activeWeek := ""
+	for week, count := range data.LoginCount {
+		if week < currentWeek && count > 0 && week > activeWeek {
+			activeWeek = week
+		}
+	}
+	if activeWeek == "" {
+		return 0, "", false // No earlier activity, can't determine churn
+	}
 
-	// Check if they had activity last week but not this week
-	if previousCount > 0 && currentCount == 0 {
-		logrus.Infof("player is churning: previousWeek=%s (%d), currentWeek=%s (%d)",
-			previousWeek, previousCount, currentWeek, currentCount)
-		return true
+	activeCount := data.LoginCount[activeWeek]
+	currentCount := data.LoginCount[currentWeek]
+	if activeCount < r.minSessionsLastWeek {
+		return 0, activeWeek, false
 	}
 
-	// Alternative: Check if they had activity in any recent week but none currently
-	// This handles multi-week absences where they might have been gone for 2+ weeks
-	if currentCount == 0 {
-		// Check if they had any activity in the past 2 weeks
-		for week, count := range data.LoginCount {
-			if week != currentWeek && count > 0 {
-				logrus.Infof("player is churning: had activity in week %s (%d), none in currentWeek=%s",
-					week, count, currentWeek)
-				return true
-			}
-		}
+	decline := 1 - float64(currentCount)/float64(activeCount)
+	if decline < r.declineThreshold {
+		return decline, activeWeek, false
 	}
 
-	return false
+	logrus.Infof("player is churning: lastActiveWeek=%s (%d), currentWeek=%s (%d), decline=%.2f",
+		activeWeek, activeCount, currentWeek, currentCount, decline)
+	return decline, activeWeek, true
 }
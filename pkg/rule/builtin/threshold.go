@@ -0,0 +1,139 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
+	"github.com/AccelByte/extend-churn-intervention/pkg/rule"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ThresholdRuleID is the identifier for the generic threshold rule
+	ThresholdRuleID = "threshold"
+
+	// DefaultThresholdField is the signal metadata field compared by default
+	DefaultThresholdField = signal.MetadataValue
+
+	// DefaultThresholdOperator is the comparison used by default
+	DefaultThresholdOperator = "gte"
+)
+
+// thresholdOperators compare a signal value (left) with the threshold (right).
+// Operators are words because ">" starts a folded scalar in YAML.
+var thresholdOperators = map[string]func(value, threshold float64) bool{
+	"gt":  func(value, threshold float64) bool { return value > threshold },
+	"gte": func(value, threshold float64) bool { return value >= threshold },
+	"lt":  func(value, threshold float64) bool { return value < threshold },
+	"lte": func(value, threshold float64) bool { return value <= threshold },
+	"eq":  func(value, threshold float64) bool { return value == threshold },
+}
+
+// ThresholdParams is the parameter schema of the threshold rule.
+var ThresholdParams = param.Schema{
+	{Name: "signal_type", Type: param.TypeString, Required: true,
+		Description: "Signal type to evaluate, e.g. one declared in the signals section"},
+	{Name: "threshold", Type: param.TypeNumber, Required: true,
+		Description: "Value the signal field is compared with"},
+	{Name: "field", Type: param.TypeString, Default: DefaultThresholdField,
+		Description: "Signal metadata field to compare: value, or an additional_data field of the signal mapping"},
+	{Name: "operator", Type: param.TypeString, Default: DefaultThresholdOperator,
+		Description: "Comparison of the field with the threshold: gt, gte, lt, lte or eq"},
+}
+
+// ThresholdRule triggers when a numeric field of a signal crosses a threshold.
+// It works on any signal, in particular those mapped from stats in the signals
+// section of pipeline.yaml, so a new stat needs no Go code.
+type ThresholdRule struct {
+	config     rule.RuleConfig
+	signalType string
+	field      string
+	operator   string
+	threshold  float64
+	compare    func(value, threshold float64) bool
+}
+
+// NewThresholdRule creates a new threshold rule.
+func NewThresholdRule(config rule.RuleConfig) (*ThresholdRule, error) {
+	operator := config.GetString("operator", DefaultThresholdOperator)
+	compare, ok := thresholdOperators[operator]
+	if !ok {
+		return nil, fmt.Errorf("unknown operator %q (must be gt, gte, lt, lte or eq)", operator)
+	}
+
+	r := &ThresholdRule{
+		config:     config,
+		signalType: config.GetString("signal_type", ""),
+		field:      config.GetString("field", DefaultThresholdField),
+		operator:   operator,
+		threshold:  config.GetFloat("threshold", 0),
+		compare:    compare,
+	}
+
+	logrus.Infof("creating threshold rule on %s: %s %s %v", r.signalType, r.field, r.operator, r.threshold)
+
+	return r, nil
+}
+
+// ID returns the rule identifier.
+func (r *ThresholdRule) ID() string {
+	return r.config.ID
+}
+
+// Name returns the rule name.
+func (r *ThresholdRule) Name() string {
+	return "Threshold"
+}
+
+// SignalTypes returns the signal types this rule handles.
+func (r *ThresholdRule) SignalTypes() []string {
+	return []string{r.signalType}
+}
+
+// Config returns the rule configuration.
+func (r *ThresholdRule) Config() rule.RuleConfig {
+	return r.config
+}
+
+// Evaluate checks if the signal field crosses the threshold.
+// Signals without the field do not match.
+func (r *ThresholdRule) Evaluate(ctx context.Context, sig signal.Signal) (bool, *rule.Trigger, error) {
+	raw, ok := sig.Metadata()[r.field]
+	if !ok {
+		logrus.Debugf("threshold rule %s: signal %s of user %s has no field %s", r.ID(), sig.Type(), sig.UserID(), r.field)
+		return false, nil, nil
+	}
+
+	var value float64
+	switch v := raw.(type) {
+	case float64:
+		value = v
+	case int:
+		value = float64(v)
+	default:
+		return false, nil, fmt.Errorf("signal %s field %s is not a number: %v (%T)", sig.Type(), r.field, raw, raw)
+	}
+
+	logrus.Debugf("evaluating threshold rule %s for user %s: %s=%v, %s %v",
+		r.ID(), sig.UserID(), r.field, value, r.operator, r.threshold)
+
+	if !r.compare(value, r.threshold) {
+		return false, nil, nil
+	}
+
+	reason := fmt.Sprintf("%s %s %s %v", sig.Type(), r.field, r.operator, r.threshold)
+	trigger := rule.NewTrigger(r.ID(), sig.UserID(), reason, r.config.Priority)
+	trigger.Metadata["signal_type"] = sig.Type()
+	trigger.Metadata["field"] = r.field
+	trigger.Metadata["value"] = value
+	trigger.Metadata["threshold"] = r.threshold
+	if statCode, ok := sig.Metadata()[signal.MetadataStatCode]; ok {
+		trigger.Metadata["stat_code"] = statCode
+	}
+
+	logrus.Infof("threshold rule %s triggered for user %s: %s=%v", r.ID(), sig.UserID(), r.field, value)
+
+	return true, trigger, nil
+}
@@ -0,0 +1,166 @@
+package rule
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+// ConditionState is the view of a player's ChurnState exposed to rule conditions as `state`.
+// Field names in expressions use the `cel` tags, e.g. `state.active_interventions == 0`.
+type ConditionState struct {
+	ActiveInterventions int64     `cel:"active_interventions"`
+	InterventionCount   int64     `cel:"intervention_count"`
+	SignalCount         int64     `cel:"signal_count"`
+	OnCooldown          bool      `cel:"on_cooldown"`
+	LastInterventionAt  time.Time `cel:"last_intervention_at"`
+}
+
+// Conditions is a compiled set of named rule conditions.
+// A rule only triggers when every condition evaluates to true.
+//
+// Conditions are CEL expressions (https://github.com/google/cel-spec) over three variables:
+//   - signal:  the signal metadata plus `type`, `user_id` and `timestamp`
+//   - session: PlayerContext.SessionInfo
+//   - state:   a ConditionState derived from the player's ChurnState
+//
+// Example:
+//
+//	conditions:
+//	  long_streak: signal.current_streak >= 5
+//	  no_active_intervention: state.active_interventions == 0
+type Conditions struct {
+	conditions []compiledCondition
+}
+
+// compiledCondition is a single type-checked condition expression.
+type compiledCondition struct {
+	name       string
+	expression string
+	program    cel.Program
+}
+
+// conditionEnv declares the variables available to condition expressions.
+var conditionEnv = mustConditionEnv()
+
+func mustConditionEnv() *cel.Env {
+	env, err := cel.NewEnv(
+		ext.NativeTypes(reflect.TypeOf(&ConditionState{}), ext.ParseStructTags(true)),
+		cel.Variable("signal", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("session", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("state", cel.ObjectType("rule.ConditionState")),
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create rule condition environment: %v", err))
+	}
+	return env
+}
+
+// CompileConditions parses and type-checks rule conditions.
+// Each value must be a CEL expression string returning bool.
+// Returns nil when there are no conditions.
+func CompileConditions(conditions map[string]interface{}) (*Conditions, error) {
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(conditions))
+	for name := range conditions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	compiled := &Conditions{}
+	for _, name := range names {
+		expression, ok := conditions[name].(string)
+		if !ok {
+			return nil, fmt.Errorf("condition %s must be an expression string, got %T", name, conditions[name])
+		}
+
+		ast, iss := conditionEnv.Compile(expression)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("condition %s: %w", name, iss.Err())
+		}
+
+		if ast.OutputType() != cel.BoolType {
+			return nil, fmt.Errorf("condition %s must evaluate to bool, got %s", name, ast.OutputType())
+		}
+
+		program, err := conditionEnv.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("condition %s: %w", name, err)
+		}
+
+		compiled.conditions = append(compiled.conditions, compiledCondition{
+			name:       name,
+			expression: expression,
+			program:    program,
+		})
+	}
+
+	return compiled, nil
+}
+
+// Evaluate checks all conditions against the signal in name order.
+// Returns false and the name of the first condition that did not hold.
+// Returns an error if a condition cannot be evaluated, e.g. when it reads
+// a signal metadata key the signal does not carry (guard with `has(signal.key)`).
+func (c *Conditions) Evaluate(sig signal.Signal) (bool, string, error) {
+	if c == nil {
+		return true, "", nil
+	}
+
+	activation := conditionActivation(sig)
+	for _, condition := range c.conditions {
+		out, _, err := condition.program.Eval(activation)
+		if err != nil {
+			return false, condition.name, fmt.Errorf("condition %s (%s): %w", condition.name, condition.expression, err)
+		}
+
+		if matched, ok := out.Value().(bool); !ok || !matched {
+			return false, condition.name, nil
+		}
+	}
+
+	return true, "", nil
+}
+
+// conditionActivation builds the variables for evaluating conditions against a signal.
+func conditionActivation(sig signal.Signal) map[string]interface{} {
+	signalVars := make(map[string]interface{}, len(sig.Metadata())+3)
+	for key, value := range sig.Metadata() {
+		signalVars[key] = value
+	}
+	signalVars["type"] = sig.Type()
+	signalVars["user_id"] = sig.UserID()
+	signalVars["timestamp"] = sig.Timestamp()
+
+	sessionVars := map[string]interface{}{}
+	state := &ConditionState{}
+
+	if playerCtx := sig.Context(); playerCtx != nil {
+		if playerCtx.SessionInfo != nil {
+			sessionVars = playerCtx.SessionInfo
+		}
+
+		if churnState := playerCtx.State; churnState != nil {
+			state.ActiveInterventions = int64(len(churnState.GetActiveInterventions(sig.Timestamp())))
+			state.InterventionCount = int64(len(churnState.InterventionHistory))
+			state.SignalCount = int64(len(churnState.SignalHistory))
+			state.OnCooldown = churnState.Cooldown.IsOnCooldown(sig.Timestamp())
+			state.LastInterventionAt = churnState.Cooldown.LastInterventionAt
+		}
+	}
+
+	return map[string]interface{}{
+		"signal":  signalVars,
+		"session": sessionVars,
+		"state":   state,
+	}
+}
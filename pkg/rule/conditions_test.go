@@ -0,0 +1,229 @@
+package rule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
+)
+
+func newLosingStreakTestSignal(streak int, state *service.ChurnState) signal.Signal {
+	playerCtx := &signal.PlayerContext{
+		UserID:      "test-user",
+		State:       state,
+		SessionInfo: map[string]interface{}{"on_cooldown": false},
+	}
+	return signalBuiltin.NewLosingStreakSignal("test-user", time.Now(), streak, playerCtx)
+}
+
+func TestCompileConditions_Empty(t *testing.T) {
+	conditions, err := CompileConditions(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	held, _, err := conditions.Evaluate(newLosingStreakTestSignal(1, &service.ChurnState{}))
+	if err != nil || !held {
+		t.Errorf("Expected empty conditions to hold, got held=%v err=%v", held, err)
+	}
+}
+
+func TestCompileConditions_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions map[string]interface{}
+	}{
+		{"syntax error", map[string]interface{}{"bad": "signal.current_streak >="}},
+		{"unknown variable", map[string]interface{}{"bad": "player.level > 3"}},
+		{"unknown state field", map[string]interface{}{"bad": "state.level > 3"}},
+		{"non-bool result", map[string]interface{}{"bad": "state.active_interventions + 1"}},
+		{"non-string value", map[string]interface{}{"bad": 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := CompileConditions(tt.conditions); err == nil {
+				t.Error("Expected compile error")
+			}
+		})
+	}
+}
+
+func TestConditions_Evaluate(t *testing.T) {
+	conditions, err := CompileConditions(map[string]interface{}{
+		"long_streak":            "signal.current_streak >= 5",
+		"no_active_intervention": "state.active_interventions == 0",
+		"not_on_cooldown":        "!session.on_cooldown",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	activeState := &service.ChurnState{
+		InterventionHistory: []service.InterventionRecord{{ID: "i-1", Outcome: "active"}},
+	}
+
+	tests := []struct {
+		name       string
+		sig        signal.Signal
+		wantHeld   bool
+		wantFailed string
+	}{
+		{"all hold", newLosingStreakTestSignal(6, &service.ChurnState{}), true, ""},
+		{"short streak", newLosingStreakTestSignal(3, &service.ChurnState{}), false, "long_streak"},
+		{"active intervention", newLosingStreakTestSignal(6, activeState), false, "no_active_intervention"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			held, failed, err := conditions.Evaluate(tt.sig)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if held != tt.wantHeld || failed != tt.wantFailed {
+				t.Errorf("Evaluate() = (%v, %q), want (%v, %q)", held, failed, tt.wantHeld, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestConditions_Evaluate_MissingMetadata(t *testing.T) {
+	conditions, err := CompileConditions(map[string]interface{}{
+		"many_quits": "signal.quit_count > 2",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, _, err := conditions.Evaluate(newLosingStreakTestSignal(6, &service.ChurnState{})); err == nil {
+		t.Error("Expected error for missing signal metadata key")
+	}
+
+	guarded, err := CompileConditions(map[string]interface{}{
+		"many_quits": "has(signal.quit_count) && signal.quit_count > 2",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	held, _, err := guarded.Evaluate(newLosingStreakTestSignal(6, &service.ChurnState{}))
+	if err != nil || held {
+		t.Errorf("Expected guarded condition not to hold, got held=%v err=%v", held, err)
+	}
+}
+
+func TestEngine_Evaluate_Conditions(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&testRule{
+		id:          "conditional_rule",
+		name:        "Conditional Rule",
+		signalTypes: []string{signalBuiltin.TypeLosingStreak},
+		config: RuleConfig{
+			ID:         "conditional_rule",
+			Enabled:    true,
+			Conditions: map[string]interface{}{"long_streak": "signal.current_streak >= 5"},
+		},
+		shouldMatch: true,
+	})
+	engine := NewEngine(registry)
+
+	triggers, err := engine.Evaluate(testContext(), newLosingStreakTestSignal(3, &service.ChurnState{}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(triggers) != 0 {
+		t.Errorf("Expected condition to suppress trigger, got %d triggers", len(triggers))
+	}
+
+	triggers, err = engine.Evaluate(testContext(), newLosingStreakTestSignal(5, &service.ChurnState{}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(triggers) != 1 {
+		t.Errorf("Expected rule to trigger when condition holds, got %d triggers", len(triggers))
+	}
+}
+
+// valueRule is a rule implemented on a non-comparable value type, as plugin rules may be.
+type valueRule struct {
+	config RuleConfig
+}
+
+func (r valueRule) ID() string            { return r.config.ID }
+func (r valueRule) Name() string          { return r.config.ID }
+func (r valueRule) SignalTypes() []string { return []string{signalBuiltin.TypeLosingStreak} }
+func (r valueRule) Config() RuleConfig    { return r.config }
+
+func (r valueRule) Evaluate(ctx context.Context, sig signal.Signal) (bool, *Trigger, error) {
+	return true, NewTrigger(r.config.ID, sig.UserID(), "value rule", r.config.Priority), nil
+}
+
+func TestEngine_Evaluate_ConditionsOfNonComparableRule(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(valueRule{config: RuleConfig{
+		ID:         "value_rule",
+		Enabled:    true,
+		Conditions: map[string]interface{}{"long_streak": "signal.current_streak >= 5"},
+	}})
+	engine := NewEngine(registry)
+
+	for _, streak := range []int{5, 3} {
+		triggers, err := engine.Evaluate(testContext(), newLosingStreakTestSignal(streak, &service.ChurnState{}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := streak >= 5; (len(triggers) == 1) != want {
+			t.Errorf("streak %d: expected trigger %v, got %d triggers", streak, want, len(triggers))
+		}
+	}
+}
+
+func TestEngine_Evaluate_MatchContext(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&testRule{
+		id:          "ranked_rage_quit",
+		name:        "Ranked Rage Quit",
+		signalTypes: []string{signalBuiltin.TypeRageQuit},
+		config: RuleConfig{
+			ID:         "ranked_rage_quit",
+			Enabled:    true,
+			Conditions: map[string]interface{}{"ranked": `has(signal.match_mode) && signal.match_mode == "ranked"`},
+		},
+		shouldMatch: true,
+	})
+	engine := NewEngine(registry)
+
+	rageQuit := func(mode string) signal.Signal {
+		sig := signalBuiltin.NewRageQuitSignal("test-user", time.Now(), 3, &signal.PlayerContext{State: &service.ChurnState{}})
+		if mode != "" {
+			match := &signal.MatchContext{MatchID: "match-42", Mode: mode, Data: map[string]interface{}{"mode": mode}}
+			match.AddMetadata(sig.Metadata())
+			sig.WithMatch(match)
+		}
+		return sig
+	}
+
+	for _, mode := range []string{"", "casual"} {
+		triggers, err := engine.Evaluate(testContext(), rageQuit(mode))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(triggers) != 0 {
+			t.Errorf("Expected no trigger outside ranked (mode %q), got %d", mode, len(triggers))
+		}
+	}
+
+	triggers, err := engine.Evaluate(testContext(), rageQuit("ranked"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(triggers) != 1 {
+		t.Fatalf("Expected rule to trigger in ranked, got %d triggers", len(triggers))
+	}
+	if triggers[0].Metadata[signal.MetadataMatchID] != "match-42" {
+		t.Errorf("Expected trigger to reference the match, got %v", triggers[0].Metadata)
+	}
+}
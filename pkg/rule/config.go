@@ -10,11 +10,28 @@ type RuleConfig struct {
 	Type       string                 `yaml:"type" json:"type"` // e.g., "builtin.rage_quit"
 	Enabled    bool                   `yaml:"enabled" json:"enabled"`
 	Priority   int                    `yaml:"priority" json:"priority"`
+	Mode       string                 `yaml:"mode" json:"mode"` // "live" (default) or "shadow"
 	Cooldown   *CooldownConfig        `yaml:"cooldown,omitempty" json:"cooldown,omitempty"`
 	Conditions map[string]interface{} `yaml:"conditions" json:"conditions"`
 	Parameters map[string]interface{} `yaml:"parameters" json:"parameters"` // Rule-specific parameters
 }
 
+// Modes of rules and actions.
+const (
+	// ModeLive runs the rule or action normally. It is the default.
+	ModeLive = "live"
+
+	// ModeShadow evaluates a rule and records would-be triggers, but actions
+	// for its triggers only record what they would have done. An action in
+	// shadow mode records what it would have done without executing it.
+	ModeShadow = "shadow"
+)
+
+// IsShadow reports whether the rule runs in shadow mode.
+func (c *RuleConfig) IsShadow() bool {
+	return c.Mode == ModeShadow
+}
+
 // CooldownConfig defines rate limiting for rule triggers.
 type CooldownConfig struct {
 	Duration time.Duration `yaml:"duration" json:"duration"`
@@ -71,11 +88,14 @@ func (c *RuleConfig) GetInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
-// GetFloat retrieves a float value from parameters with a default.
+// GetFloat retrieves a float value from parameters with a default. Integers are converted.
 func (c *RuleConfig) GetFloat(key string, defaultValue float64) float64 {
 	if val, ok := c.Parameters[key]; ok {
-		if floatVal, ok := val.(float64); ok {
-			return floatVal
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v) // YAML decodes whole numbers such as "1" as int
 		}
 	}
 	return defaultValue
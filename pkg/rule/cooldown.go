@@ -0,0 +1,90 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+)
+
+const (
+	// CooldownScopePerUser limits a rule to one trigger per player per cooldown window.
+	CooldownScopePerUser = "per_user"
+	// CooldownScopeGlobal limits a rule to one trigger across all players per cooldown window.
+	CooldownScopeGlobal = "global"
+)
+
+// Validate checks the cooldown configuration. A nil cooldown is valid and disables it.
+func (c *CooldownConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Duration <= 0 {
+		return fmt.Errorf("duration must be positive, got %v", c.Duration)
+	}
+
+	switch c.Scope {
+	case "", CooldownScopePerUser, CooldownScopeGlobal:
+	default:
+		return fmt.Errorf("unknown scope %q (expected %s or %s)", c.Scope, CooldownScopePerUser, CooldownScopeGlobal)
+	}
+
+	return nil
+}
+
+// Key returns the cooldown key for a rule trigger. Scope defaults to per_user.
+func (c *CooldownConfig) Key(ruleID, userID string) string {
+	if c.Scope == CooldownScopeGlobal {
+		return ruleID
+	}
+	return ruleID + ":" + userID
+}
+
+// memoryCooldownStore is an in-process RuleCooldownStore.
+// It is used when no persistent store is configured (e.g. tests and local tools).
+type memoryCooldownStore struct {
+	mu    sync.Mutex
+	clock clock.Clock
+	until map[string]time.Time
+}
+
+func newMemoryCooldownStore(c clock.Clock) *memoryCooldownStore {
+	return &memoryCooldownStore{clock: c, until: make(map[string]time.Time)}
+}
+
+// AcquireCooldown starts a cooldown for key unless one is already active.
+func (s *memoryCooldownStore) AcquireCooldown(ctx context.Context, key string, duration time.Duration) (bool, error) {
+	key, err := namespace.Key(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire rule cooldown: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	if now.Before(s.until[key]) {
+		return false, nil
+	}
+
+	s.until[key] = now.Add(duration)
+	return true, nil
+}
+
+// ClearCooldown ends the cooldown for key.
+func (s *memoryCooldownStore) ClearCooldown(ctx context.Context, key string) error {
+	key, err := namespace.Key(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to clear rule cooldown: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.until, key)
+	return nil
+}
@@ -2,53 +2,109 @@ package rule
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"sync"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer creates a span per rule evaluation using the global tracer provider.
+var tracer = otel.Tracer("github.com/AccelByte/extend-churn-intervention/pkg/rule")
+
 // Engine evaluates signals against registered rules and returns triggers.
+// Rules configured with conditions only trigger when every condition holds, and
+// rules configured with a cooldown trigger at most once per cooldown window
+// (per player or globally), regardless of how the rule itself is written.
 type Engine struct {
-	registry *Registry
+	registry      *Registry
+	cooldownStore service.RuleCooldownStore
+	stats         *engineStats
+
+	conditionsMu sync.Mutex
+	conditions   map[string]*Conditions
 }
 
 // NewEngine creates a new rule evaluation engine.
+// Rule cooldowns are kept in memory, timed by the wall clock, until SetCooldownStore is called.
 func NewEngine(registry *Registry) *Engine {
 	return &Engine{
-		registry: registry,
+		registry:      registry,
+		cooldownStore: newMemoryCooldownStore(clock.Real{}),
+		stats:         newEngineStats(),
+		conditions:    make(map[string]*Conditions),
+	}
+}
+
+// SetClock sets the clock that times the in-memory rule cooldowns.
+// It must be called before evaluation starts.
+func (e *Engine) SetClock(c clock.Clock) {
+	if _, ok := e.cooldownStore.(*memoryCooldownStore); ok {
+		e.cooldownStore = newMemoryCooldownStore(c)
 	}
 }
 
+// SetCooldownStore sets the store used to enforce rule cooldowns.
+// Use a persistent store so cooldowns survive restarts and span replicas.
+func (e *Engine) SetCooldownStore(store service.RuleCooldownStore) {
+	e.cooldownStore = store
+}
+
+// WithRegistry returns a new engine evaluating the rules in registry.
+// The new engine shares this engine's cooldown store and statistics, so cooldowns
+// already running and counters survive a configuration reload.
+func (e *Engine) WithRegistry(registry *Registry) *Engine {
+	engine := NewEngine(registry)
+	engine.cooldownStore = e.cooldownStore
+	engine.stats = e.stats
+	return engine
+}
+
+// Stats returns a snapshot of the rule evaluation counters.
+func (e *Engine) Stats() EngineStats {
+	return e.stats.snapshot()
+}
+
 // Evaluate evaluates a signal against all matching rules.
 // Returns a list of triggers for rules that matched.
 func (e *Engine) Evaluate(ctx context.Context, sig signal.Signal) ([]*Trigger, error) {
+	triggers, _, err := e.EvaluateWithDecisions(ctx, sig)
+	return triggers, err
+}
+
+// EvaluateWithDecisions evaluates a signal like Evaluate and additionally returns the
+// decision taken for every rule evaluated, in evaluation order, for auditing.
+func (e *Engine) EvaluateWithDecisions(ctx context.Context, sig signal.Signal) ([]*Trigger, []Decision, error) {
 	if sig == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// Get rules that handle this signal type
 	rules := e.registry.GetBySignalType(sig.Type())
 	if len(rules) == 0 {
 		logrus.Debugf("no rules found for signal type '%s'", sig.Type())
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	logrus.Debugf("evaluating signal type '%s' against %d rules", sig.Type(), len(rules))
 
 	var triggers []*Trigger
+	decisions := make([]Decision, 0, len(rules))
 
 	// Evaluate each rule
 	for _, rule := range rules {
-		matched, trigger, err := rule.Evaluate(ctx, sig)
-		if err != nil {
-			logrus.Errorf("rule %s evaluation failed: %v", rule.ID(), err)
-			// Continue evaluating other rules even if one fails
-			continue
-		}
-
-		if matched && trigger != nil {
-			logrus.Infof("rule %s triggered for user %s: %s", rule.ID(), sig.UserID(), trigger.Reason)
+		trigger, decision := e.evaluateRule(ctx, rule, sig)
+		decisions = append(decisions, decision)
+		if trigger != nil {
 			triggers = append(triggers, trigger)
 		}
 	}
@@ -60,7 +116,195 @@ func (e *Engine) Evaluate(ctx context.Context, sig signal.Signal) ([]*Trigger, e
 		})
 	}
 
-	return triggers, nil
+	return triggers, decisions, nil
+}
+
+// evaluateRule evaluates a single rule against a signal in its own span.
+// Returns the trigger if the rule matched and is not on cooldown, and the decision taken.
+// Errors are logged, counted and recorded in the decision rather than returned so that
+// one failing rule does not stop the others.
+func (e *Engine) evaluateRule(ctx context.Context, rule Rule, sig signal.Signal) (*Trigger, Decision) {
+	ctx, span := tracer.Start(ctx, "rule.evaluate", trace.WithAttributes(
+		attribute.String("rule.id", rule.ID()),
+		attribute.String("user.id", sig.UserID()),
+		attribute.String("signal.type", sig.Type()),
+	))
+	defer span.End()
+
+	ns := namespace.FromContext(ctx)
+	metrics.RuleEvaluationsTotal.WithLabelValues(ns, rule.ID()).Inc()
+	e.stats.update(rule.ID(), func(s *RuleStats) { s.Evaluations++ })
+
+	decide := func(outcome, reason string) Decision {
+		return Decision{RuleID: rule.ID(), Outcome: outcome, Reason: reason}
+	}
+
+	fail := func(err error) (*Trigger, Decision) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.stats.update(rule.ID(), func(s *RuleStats) { s.Errors++ })
+		return nil, decide(DecisionError, err.Error())
+	}
+
+	conditions, err := e.getConditions(rule)
+	if err != nil {
+		logrus.Errorf("rule %s has invalid conditions: %v", rule.ID(), err)
+		return fail(err)
+	}
+
+	held, failed, err := conditions.Evaluate(sig)
+	if err != nil {
+		logrus.Errorf("rule %s condition evaluation failed: %v", rule.ID(), err)
+		return fail(err)
+	}
+	if !held {
+		logrus.Debugf("rule %s skipped for user %s: condition %s not met", rule.ID(), sig.UserID(), failed)
+		span.SetAttributes(attribute.String("rule.failed_condition", failed))
+		return nil, decide(DecisionConditionNotMet, fmt.Sprintf("condition %s not met", failed))
+	}
+
+	matched, trigger, err := rule.Evaluate(ctx, sig)
+	if err != nil {
+		logrus.Errorf("rule %s evaluation failed: %v", rule.ID(), err)
+		return fail(err)
+	}
+
+	span.SetAttributes(attribute.Bool("rule.matched", matched && trigger != nil))
+	if !matched || trigger == nil {
+		return nil, decide(DecisionNotMatched, "rule did not match")
+	}
+
+	// Triggers happen in event time, so that actions record when the player did what
+	// triggered them, however late the event arrived
+	trigger.Timestamp = sig.Timestamp()
+
+	// Let actions reference the match that caused the trigger
+	if trigger.Metadata == nil {
+		trigger.Metadata = make(map[string]interface{})
+	}
+	signal.CopyMatchMetadata(trigger.Metadata, sig.Metadata())
+
+	allowed, err := e.acquireCooldown(ctx, rule, sig.UserID())
+	if err != nil {
+		logrus.Errorf("rule %s cooldown check failed, suppressing trigger: %v", rule.ID(), err)
+		return fail(err)
+	}
+	if !allowed {
+		logrus.Infof("rule %s matched for user %s but is on cooldown, suppressing trigger", rule.ID(), sig.UserID())
+		span.SetAttributes(attribute.Bool("rule.cooldown_suppressed", true))
+		metrics.RuleCooldownSuppressedTotal.WithLabelValues(ns, rule.ID()).Inc()
+		e.stats.update(rule.ID(), func(s *RuleStats) { s.CooldownSuppressed++ })
+		return nil, decide(DecisionCooldownSuppressed, trigger.Reason)
+	}
+
+	// Shadow rules keep their cooldown so that would-be triggers reflect the live trigger rate
+	if config := rule.Config(); config.IsShadow() {
+		trigger.Shadow = true
+		logrus.Infof("[SHADOW] rule %s would trigger for user %s: %s", rule.ID(), sig.UserID(), trigger.Reason)
+		metrics.RuleShadowTriggersTotal.WithLabelValues(ns, rule.ID()).Inc()
+	} else {
+		logrus.Infof("rule %s triggered for user %s: %s", rule.ID(), sig.UserID(), trigger.Reason)
+		metrics.RuleTriggersTotal.WithLabelValues(ns, rule.ID()).Inc()
+	}
+	span.SetAttributes(
+		attribute.Bool("rule.triggered", true),
+		attribute.Bool("rule.shadow", trigger.Shadow),
+	)
+	e.stats.update(rule.ID(), func(s *RuleStats) {
+		s.Triggers++
+		if trigger.Shadow {
+			s.ShadowTriggers++
+		}
+	})
+
+	if trigger.Shadow {
+		return trigger, decide(DecisionShadowTriggered, trigger.Reason)
+	}
+	return trigger, decide(DecisionTriggered, trigger.Reason)
+}
+
+// getConditions returns the rule's compiled conditions, compiling them on first use.
+// Compiled conditions are cached by rule ID, which is unique within the registry.
+// Conditions are already validated when the pipeline config is parsed, so this only
+// fails for rules configured outside the pipeline config.
+func (e *Engine) getConditions(rule Rule) (*Conditions, error) {
+	e.conditionsMu.Lock()
+	defer e.conditionsMu.Unlock()
+
+	if conditions, ok := e.conditions[rule.ID()]; ok {
+		return conditions, nil
+	}
+
+	conditions, err := CompileConditions(rule.Config().Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	e.conditions[rule.ID()] = conditions
+	return conditions, nil
+}
+
+// acquireCooldown starts the rule's cooldown and reports whether the rule may trigger.
+// Rules without a cooldown may always trigger.
+func (e *Engine) acquireCooldown(ctx context.Context, rule Rule, userID string) (bool, error) {
+	cooldown := rule.Config().Cooldown
+	if cooldown == nil || cooldown.Duration <= 0 || e.cooldownStore == nil {
+		return true, nil
+	}
+
+	return e.cooldownStore.AcquireCooldown(ctx, cooldown.Key(rule.ID(), userID), cooldown.Duration)
+}
+
+// ReleaseCooldown ends the cooldown a trigger started, so that the rule can trigger again.
+// Cooldowns are started when a rule triggers, so that concurrent events cannot both trigger
+// it; the caller releases the cooldown when the trigger's actions failed, so that the failed
+// intervention is not suppressed for the whole cooldown window.
+func (e *Engine) ReleaseCooldown(ctx context.Context, trigger *Trigger) error {
+	rule := e.registry.Get(trigger.RuleID)
+	if rule == nil {
+		return nil
+	}
+
+	cooldown := rule.Config().Cooldown
+	if cooldown == nil || cooldown.Duration <= 0 || e.cooldownStore == nil {
+		return nil
+	}
+
+	if err := e.cooldownStore.ClearCooldown(ctx, cooldown.Key(rule.ID(), trigger.UserID)); err != nil {
+		return fmt.Errorf("failed to release cooldown of rule %s: %w", rule.ID(), err)
+	}
+	return nil
+}
+
+// ClearUserCooldowns ends the per-player cooldowns of all rules for userID, so the rules
+// can trigger for the player again. Global cooldowns are left alone.
+// Returns the IDs of the rules with a per-player cooldown, sorted.
+func (e *Engine) ClearUserCooldowns(ctx context.Context, userID string) ([]string, error) {
+	return e.clearUserCooldowns(ctx, userID, e.registry.GetAll())
+}
+
+// ClearUserSignalCooldowns ends the per-player cooldowns for userID of the rules handling
+// signalType, like ClearUserCooldowns. Returns the IDs of the affected rules, sorted.
+func (e *Engine) ClearUserSignalCooldowns(ctx context.Context, userID, signalType string) ([]string, error) {
+	return e.clearUserCooldowns(ctx, userID, e.registry.GetBySignalType(signalType))
+}
+
+func (e *Engine) clearUserCooldowns(ctx context.Context, userID string, rules []Rule) ([]string, error) {
+	var cleared []string
+	for _, rule := range rules {
+		cooldown := rule.Config().Cooldown
+		if cooldown == nil || cooldown.Duration <= 0 || cooldown.Scope == CooldownScopeGlobal || e.cooldownStore == nil {
+			continue
+		}
+
+		if err := e.cooldownStore.ClearCooldown(ctx, cooldown.Key(rule.ID(), userID)); err != nil {
+			return cleared, fmt.Errorf("failed to clear cooldown of rule %s: %w", rule.ID(), err)
+		}
+		cleared = append(cleared, rule.ID())
+	}
+
+	sort.Strings(cleared)
+	return cleared, nil
 }
 
 // EvaluateMultiple evaluates multiple signals in sequence.
@@ -5,11 +5,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 	signalBuiltin "github.com/AccelByte/extend-churn-intervention/pkg/signal/builtin"
 )
 
+// testContext returns a context scoped to the test namespace, as the pipeline scopes the
+// context of each event.
+func testContext() context.Context {
+	return namespace.NewContext(context.Background(), "test-namespace", "test-namespace")
+}
+
 // testRule is a rule that always matches for testing
 type testRule struct {
 	id          string
@@ -71,7 +83,7 @@ func TestEngine_Evaluate_NoRules(t *testing.T) {
 	}
 	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
 
-	triggers, err := engine.Evaluate(context.Background(), sig)
+	triggers, err := engine.Evaluate(testContext(), sig)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -85,7 +97,7 @@ func TestEngine_Evaluate_NilSignal(t *testing.T) {
 	registry := NewRegistry()
 	engine := NewEngine(registry)
 
-	triggers, err := engine.Evaluate(context.Background(), nil)
+	triggers, err := engine.Evaluate(testContext(), nil)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -112,9 +124,11 @@ func TestEngine_Evaluate_SingleMatchingRule(t *testing.T) {
 		UserID: "test-user",
 		State:  &service.ChurnState{},
 	}
-	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
+	// The event occurred an hour before it is evaluated
+	eventTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	sig := signalBuiltin.NewLoginSignal("test-user", eventTime, playerCtx)
 
-	triggers, err := engine.Evaluate(context.Background(), sig)
+	triggers, err := engine.Evaluate(testContext(), sig)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -130,6 +144,10 @@ func TestEngine_Evaluate_SingleMatchingRule(t *testing.T) {
 	if triggers[0].UserID != "test-user" {
 		t.Errorf("Expected user ID 'test-user', got '%s'", triggers[0].UserID)
 	}
+
+	if !triggers[0].Timestamp.Equal(eventTime) {
+		t.Errorf("Expected the trigger to be stamped with the event time %v, got %v", eventTime, triggers[0].Timestamp)
+	}
 }
 
 func TestEngine_Evaluate_MultipleMatchingRules(t *testing.T) {
@@ -169,7 +187,7 @@ func TestEngine_Evaluate_MultipleMatchingRules(t *testing.T) {
 	}
 	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
 
-	triggers, err := engine.Evaluate(context.Background(), sig)
+	triggers, err := engine.Evaluate(testContext(), sig)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -210,7 +228,7 @@ func TestEngine_Evaluate_NoMatchingRules(t *testing.T) {
 	}
 	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
 
-	triggers, err := engine.Evaluate(context.Background(), sig)
+	triggers, err := engine.Evaluate(testContext(), sig)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -240,7 +258,7 @@ func TestEngine_Evaluate_RuleError(t *testing.T) {
 	}
 	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
 
-	triggers, err := engine.Evaluate(context.Background(), sig)
+	triggers, err := engine.Evaluate(testContext(), sig)
 	// Engine should not return error, but log it and continue
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
@@ -289,7 +307,7 @@ func TestEngine_Evaluate_MixedResults(t *testing.T) {
 	}
 	sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
 
-	triggers, err := engine.Evaluate(context.Background(), sig)
+	triggers, err := engine.Evaluate(testContext(), sig)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -304,11 +322,65 @@ func TestEngine_Evaluate_MixedResults(t *testing.T) {
 	}
 }
 
+func TestEngine_EvaluateWithDecisions(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(newCooldownTestRule(CooldownScopePerUser))
+	registry.Register(&testRule{
+		id:          "non_matching_rule",
+		name:        "Non Matching Rule",
+		signalTypes: []string{"login"},
+		config:      RuleConfig{ID: "non_matching_rule", Enabled: true},
+	})
+	registry.Register(&testRule{
+		id:          "error_rule",
+		name:        "Error Rule",
+		signalTypes: []string{"login"},
+		config:      RuleConfig{ID: "error_rule", Enabled: true},
+		shouldError: true,
+	})
+	engine := NewEngine(registry)
+
+	playerCtx := &signal.PlayerContext{
+		UserID: "test-user",
+		State:  &service.ChurnState{},
+	}
+	evaluate := func() map[string]Decision {
+		sig := signalBuiltin.NewLoginSignal("test-user", time.Now(), playerCtx)
+		_, decisions, err := engine.EvaluateWithDecisions(testContext(), sig)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		byRule := make(map[string]Decision)
+		for _, decision := range decisions {
+			byRule[decision.RuleID] = decision
+		}
+		return byRule
+	}
+
+	decisions := evaluate()
+	if len(decisions) != 3 {
+		t.Fatalf("Expected a decision per rule, got %+v", decisions)
+	}
+	if got := decisions["cooldown_rule"]; got.Outcome != DecisionTriggered || got.Reason != "test trigger" {
+		t.Errorf("Expected cooldown_rule to trigger with its reason, got %+v", got)
+	}
+	if got := decisions["non_matching_rule"]; got.Outcome != DecisionNotMatched {
+		t.Errorf("Expected non_matching_rule not to match, got %+v", got)
+	}
+	if got := decisions["error_rule"]; got.Outcome != DecisionError || got.Reason != "test error" {
+		t.Errorf("Expected error_rule to record its error, got %+v", got)
+	}
+
+	if got := evaluate()["cooldown_rule"]; got.Outcome != DecisionCooldownSuppressed {
+		t.Errorf("Expected cooldown_rule to be suppressed by its cooldown, got %+v", got)
+	}
+}
+
 func TestEngine_EvaluateMultiple_Empty(t *testing.T) {
 	registry := NewRegistry()
 	engine := NewEngine(registry)
 
-	triggers, err := engine.EvaluateMultiple(context.Background(), []signal.Signal{})
+	triggers, err := engine.EvaluateMultiple(testContext(), []signal.Signal{})
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -317,3 +389,252 @@ func TestEngine_EvaluateMultiple_Empty(t *testing.T) {
 		t.Errorf("Expected 0 triggers, got %d", len(triggers))
 	}
 }
+
+func newCooldownTestRule(scope string) *testRule {
+	return &testRule{
+		id:          "cooldown_rule",
+		name:        "Cooldown Rule",
+		signalTypes: []string{"login"},
+		config: RuleConfig{
+			ID:       "cooldown_rule",
+			Enabled:  true,
+			Cooldown: &CooldownConfig{Duration: 24 * time.Hour, Scope: scope},
+		},
+		shouldMatch: true,
+	}
+}
+
+func evaluateLogin(t *testing.T, engine *Engine, userID string) []*Trigger {
+	t.Helper()
+
+	playerCtx := &signal.PlayerContext{
+		UserID: userID,
+		State:  &service.ChurnState{},
+	}
+	triggers, err := engine.Evaluate(testContext(), signalBuiltin.NewLoginSignal(userID, time.Now(), playerCtx))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return triggers
+}
+
+func TestEngine_Evaluate_PerUserCooldown(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(newCooldownTestRule(CooldownScopePerUser))
+	engine := NewEngine(registry)
+
+	if got := len(evaluateLogin(t, engine, "user-1")); got != 1 {
+		t.Fatalf("Expected first evaluation to trigger, got %d triggers", got)
+	}
+
+	if got := len(evaluateLogin(t, engine, "user-1")); got != 0 {
+		t.Errorf("Expected second evaluation for same user to be suppressed, got %d triggers", got)
+	}
+
+	if got := len(evaluateLogin(t, engine, "user-2")); got != 1 {
+		t.Errorf("Expected evaluation for another user to trigger, got %d triggers", got)
+	}
+}
+
+func TestEngine_Evaluate_GlobalCooldown(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(newCooldownTestRule(CooldownScopeGlobal))
+	engine := NewEngine(registry)
+
+	if got := len(evaluateLogin(t, engine, "user-1")); got != 1 {
+		t.Fatalf("Expected first evaluation to trigger, got %d triggers", got)
+	}
+
+	if got := len(evaluateLogin(t, engine, "user-2")); got != 0 {
+		t.Errorf("Expected evaluation for another user to be suppressed, got %d triggers", got)
+	}
+}
+
+func TestEngine_ClearUserCooldowns(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(newCooldownTestRule(CooldownScopePerUser))
+	engine := NewEngine(registry)
+
+	evaluateLogin(t, engine, "user-1")
+	evaluateLogin(t, engine, "user-2")
+
+	cleared, err := engine.ClearUserCooldowns(testContext(), "user-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(cleared) != 1 || cleared[0] != "cooldown_rule" {
+		t.Errorf("Expected cooldown_rule to be cleared, got %v", cleared)
+	}
+
+	if got := len(evaluateLogin(t, engine, "user-1")); got != 1 {
+		t.Errorf("Expected rule to trigger after cooldown was cleared, got %d triggers", got)
+	}
+
+	if got := len(evaluateLogin(t, engine, "user-2")); got != 0 {
+		t.Errorf("Expected cooldown of another user to be kept, got %d triggers", got)
+	}
+}
+
+func TestEngine_ClearUserCooldowns_SkipsGlobalCooldown(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(newCooldownTestRule(CooldownScopeGlobal))
+	engine := NewEngine(registry)
+
+	evaluateLogin(t, engine, "user-1")
+
+	cleared, err := engine.ClearUserCooldowns(testContext(), "user-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(cleared) != 0 {
+		t.Errorf("Expected global cooldown not to be cleared, got %v", cleared)
+	}
+
+	if got := len(evaluateLogin(t, engine, "user-1")); got != 0 {
+		t.Errorf("Expected global cooldown to still apply, got %d triggers", got)
+	}
+}
+
+func TestEngine_ReleaseCooldown(t *testing.T) {
+	for _, scope := range []string{CooldownScopePerUser, CooldownScopeGlobal} {
+		t.Run(scope, func(t *testing.T) {
+			registry := NewRegistry()
+			registry.Register(newCooldownTestRule(scope))
+			engine := NewEngine(registry)
+
+			triggers := evaluateLogin(t, engine, "user-1")
+			if len(triggers) != 1 {
+				t.Fatalf("Expected first evaluation to trigger, got %d triggers", len(triggers))
+			}
+
+			if err := engine.ReleaseCooldown(testContext(), triggers[0]); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := len(evaluateLogin(t, engine, "user-1")); got != 1 {
+				t.Errorf("Expected rule to trigger after its cooldown was released, got %d triggers", got)
+			}
+		})
+	}
+}
+
+func TestEngine_Evaluate_CooldownSharedAcrossEngines(t *testing.T) {
+	mr := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+
+	newEngine := func() *Engine {
+		registry := NewRegistry()
+		registry.Register(newCooldownTestRule(CooldownScopePerUser))
+		engine := NewEngine(registry)
+		engine.SetCooldownStore(service.NewRedisRuleCooldownStore(redisClient))
+		return engine
+	}
+
+	// Two engines model two replicas (or a restart) sharing the same Redis
+	first, second := newEngine(), newEngine()
+
+	if got := len(evaluateLogin(t, first, "user-1")); got != 1 {
+		t.Fatalf("Expected first evaluation to trigger, got %d triggers", got)
+	}
+
+	if got := len(evaluateLogin(t, second, "user-1")); got != 0 {
+		t.Errorf("Expected cooldown to be enforced across engines, got %d triggers", got)
+	}
+
+	mr.FastForward(25 * time.Hour)
+
+	if got := len(evaluateLogin(t, second, "user-1")); got != 1 {
+		t.Errorf("Expected rule to trigger after cooldown expired, got %d triggers", got)
+	}
+}
+
+func TestEngine_Evaluate_CooldownNotStartedWithoutMatch(t *testing.T) {
+	registry := NewRegistry()
+	rule := newCooldownTestRule(CooldownScopePerUser)
+	rule.shouldMatch = false
+	registry.Register(rule)
+	engine := NewEngine(registry)
+
+	evaluateLogin(t, engine, "user-1")
+
+	rule.shouldMatch = true
+	if got := len(evaluateLogin(t, engine, "user-1")); got != 1 {
+		t.Errorf("Expected rule to trigger once it matches, got %d triggers", got)
+	}
+}
+
+func TestEngine_Evaluate_ShadowRule(t *testing.T) {
+	registry := NewRegistry()
+	rule := newCooldownTestRule(CooldownScopePerUser)
+	rule.config.Mode = ModeShadow
+	registry.Register(rule)
+	engine := NewEngine(registry)
+
+	triggers := evaluateLogin(t, engine, "user-1")
+	if len(triggers) != 1 {
+		t.Fatalf("Expected shadow rule to trigger, got %d triggers", len(triggers))
+	}
+	if !triggers[0].Shadow {
+		t.Error("Expected trigger of shadow rule to be marked as shadow")
+	}
+
+	if got := len(evaluateLogin(t, engine, "user-1")); got != 0 {
+		t.Errorf("Expected shadow rule to respect its cooldown, got %d triggers", got)
+	}
+}
+
+func TestEngine_Stats(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(newCooldownTestRule(CooldownScopePerUser))
+	engine := NewEngine(registry)
+
+	evaluateLogin(t, engine, "user-1")
+	evaluateLogin(t, engine, "user-1")
+
+	// Counters survive a configuration reload
+	reloaded := engine.WithRegistry(registry)
+	evaluateLogin(t, reloaded, "user-2")
+
+	stats := reloaded.Stats()
+	want := RuleStats{Evaluations: 3, Triggers: 2, CooldownSuppressed: 1}
+	if got := stats.ByRule["cooldown_rule"]; got != want {
+		t.Errorf("expected rule stats %+v, got %+v", want, got)
+	}
+	if stats.TotalEvaluations != 3 || stats.TriggersGenerated != 2 {
+		t.Errorf("unexpected totals: %+v", stats)
+	}
+}
+
+func TestEngine_Evaluate_Metrics(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&testRule{
+		id:          "metrics_rule",
+		name:        "Metrics Rule",
+		signalTypes: []string{"login"},
+		config:      RuleConfig{ID: "metrics_rule", Enabled: true},
+		shouldMatch: true,
+	})
+	registry.Register(&testRule{
+		id:          "metrics_shadow_rule",
+		name:        "Metrics Shadow Rule",
+		signalTypes: []string{"login"},
+		config:      RuleConfig{ID: "metrics_shadow_rule", Enabled: true, Mode: ModeShadow},
+		shouldMatch: true,
+	})
+	engine := NewEngine(registry)
+
+	evaluateLogin(t, engine, "user-1")
+	evaluateLogin(t, engine, "user-2")
+
+	if got := testutil.ToFloat64(metrics.RuleEvaluationsTotal.WithLabelValues("test-namespace", "metrics_rule")); got != 2 {
+		t.Errorf("expected 2 evaluations, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.RuleTriggersTotal.WithLabelValues("test-namespace", "metrics_rule")); got != 2 {
+		t.Errorf("expected 2 triggers, got %v", got)
+	}
+	// Shadow triggers are not counted as live triggers
+	if got := testutil.ToFloat64(metrics.RuleTriggersTotal.WithLabelValues("test-namespace", "metrics_shadow_rule")); got != 0 {
+		t.Errorf("expected 0 live triggers for shadow rule, got %v", got)
+	}
+}
@@ -3,6 +3,7 @@ package rule
 import (
 	"fmt"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/param"
 	"github.com/sirupsen/logrus"
 )
 
@@ -12,13 +13,34 @@ type RuleFactory func(config RuleConfig) (Rule, error)
 // factories stores registered rule factories by type
 var factories = make(map[string]RuleFactory)
 
+// schemas stores the parameter schemas of registered rule types
+var schemas = make(map[string]param.Schema)
+
 // RegisterRuleType registers a factory function for a rule type.
 // This allows external packages to register their rule types without creating import cycles.
+// Its parameters are not validated; use RegisterRuleTypeWithSchema to declare them.
 func RegisterRuleType(ruleType string, factory RuleFactory) {
+	RegisterRuleTypeWithSchema(ruleType, nil, factory)
+}
+
+// RegisterRuleTypeWithSchema registers a factory function for a rule type along with
+// the schema of the parameters the type reads. Parameters are validated against the schema
+// before the factory is called. A nil schema skips validation.
+func RegisterRuleTypeWithSchema(ruleType string, schema param.Schema, factory RuleFactory) {
 	factories[ruleType] = factory
+	schemas[ruleType] = schema
 	logrus.Debugf("registered rule type: %s", ruleType)
 }
 
+// Schemas returns the parameter schemas of the registered rule types, by type.
+func Schemas() map[string]param.Schema {
+	result := make(map[string]param.Schema, len(schemas))
+	for ruleType, schema := range schemas {
+		result[ruleType] = schema
+	}
+	return result
+}
+
 // CreateRule creates a rule instance based on the configuration.
 // Returns an error if the rule type is unknown.
 func CreateRule(config RuleConfig) (Rule, error) {
@@ -34,6 +56,14 @@ func CreateRule(config RuleConfig) (Rule, error) {
 		return nil, fmt.Errorf("unknown rule type: %s", config.Type)
 	}
 
+	if err := config.Cooldown.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cooldown for rule %s: %w", config.ID, err)
+	}
+
+	if err := schemas[config.Type].Validate(config.Parameters); err != nil {
+		return nil, fmt.Errorf("invalid parameters for rule %s: %w", config.ID, err)
+	}
+
 	return factory(config)
 }
 
@@ -150,6 +150,30 @@ func TestCreateRule_UnknownType(t *testing.T) {
 	}
 }
 
+func TestRegisterRuleType_WithoutSchema(t *testing.T) {
+	// Types registered without a schema accept any parameters
+	rule.RegisterRuleType("schemaless_rule", func(config rule.RuleConfig) (rule.Rule, error) {
+		return ruleBuiltin.NewRageQuitRule(config), nil
+	})
+
+	config := rule.RuleConfig{
+		ID:      "schemaless_rule_1",
+		Type:    "schemaless_rule",
+		Enabled: true,
+		Parameters: map[string]interface{}{
+			"anything": "goes",
+		},
+	}
+
+	r, err := rule.CreateRule(config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if r == nil {
+		t.Fatal("Expected non-nil rule")
+	}
+}
+
 func TestCreateRules_Multiple(t *testing.T) {
 	configs := []rule.RuleConfig{
 		{
@@ -33,10 +33,11 @@ type Rule interface {
 type Trigger struct {
 	RuleID    string                 // ID of the rule that triggered
 	UserID    string                 // Player who triggered the rule
-	Timestamp time.Time              // When the trigger occurred
+	Timestamp time.Time              // When the event that caused the trigger occurred; the Engine sets it from the signal
 	Reason    string                 // Human-readable reason for the trigger
 	Metadata  map[string]interface{} // Rule-specific data for actions
 	Priority  int                    // Priority for action ordering (higher = first)
+	Shadow    bool                   // Set for shadow rules; actions only record what they would do
 }
 
 // NewTrigger creates a new trigger with the given parameters.
@@ -62,3 +63,20 @@ func (t *Trigger) WithAllMetadata(metadata map[string]interface{}) *Trigger {
 	t.Metadata = metadata
 	return t
 }
+
+// Decision outcomes recorded for each rule evaluated against a signal.
+const (
+	DecisionTriggered          = "triggered"           // Rule triggered; its actions run
+	DecisionShadowTriggered    = "shadow_triggered"    // Shadow rule would have triggered
+	DecisionConditionNotMet    = "condition_not_met"   // A configured condition did not hold
+	DecisionNotMatched         = "not_matched"         // Rule evaluated but did not match
+	DecisionCooldownSuppressed = "cooldown_suppressed" // Rule matched but is on cooldown
+	DecisionError              = "error"               // Rule could not be evaluated
+)
+
+// Decision records why a rule did or did not trigger for a signal.
+type Decision struct {
+	RuleID  string // ID of the rule evaluated
+	Outcome string // One of the Decision* outcomes
+	Reason  string // Trigger reason, failed condition or error message
+}
@@ -0,0 +1,45 @@
+package rule
+
+import "github.com/AccelByte/extend-churn-intervention/pkg/stats"
+
+// EngineStats is a snapshot of the rule engine counters.
+type EngineStats struct {
+	TotalEvaluations  int64                `json:"total_evaluations"`
+	TriggersGenerated int64                `json:"triggers_generated"`
+	Errors            int64                `json:"errors"`
+	ByRule            map[string]RuleStats `json:"by_rule"`
+}
+
+// RuleStats contains the rule engine counters of a single rule.
+type RuleStats struct {
+	Evaluations        int64 `json:"evaluations"`
+	Triggers           int64 `json:"triggers"`
+	ShadowTriggers     int64 `json:"shadow_triggers"`     // Included in Triggers
+	CooldownSuppressed int64 `json:"cooldown_suppressed"` // Matches suppressed by the rule's cooldown
+	Errors             int64 `json:"errors"`
+}
+
+// engineStats maintains the live rule engine counters, by rule ID.
+type engineStats struct {
+	byRule *stats.Counters[RuleStats]
+}
+
+func newEngineStats() *engineStats {
+	return &engineStats{byRule: stats.NewCounters[RuleStats]()}
+}
+
+// update applies fn to the counters of ruleID.
+func (s *engineStats) update(ruleID string, fn func(stats *RuleStats)) {
+	s.byRule.Update(ruleID, fn)
+}
+
+// snapshot returns a copy of the counters with totals across rules.
+func (s *engineStats) snapshot() EngineStats {
+	snapshot := EngineStats{ByRule: s.byRule.Snapshot()}
+	for _, ruleStats := range snapshot.ByRule {
+		snapshot.TotalEvaluations += ruleStats.Evaluations
+		snapshot.TriggersGenerated += ruleStats.Triggers
+		snapshot.Errors += ruleStats.Errors
+	}
+	return snapshot
+}
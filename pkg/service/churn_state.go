@@ -3,9 +3,12 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/metrics"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 	"github.com/go-redis/redis/v8"
 	"github.com/sirupsen/logrus"
 )
@@ -17,6 +20,42 @@ const (
 	churnStateStoreKeyPrefix = "churn_intervention:user_state:"
 )
 
+// ErrChurnStateConflict indicates that the churn state was modified by another writer
+// since it was read. The caller should reload the state and retry the update.
+var ErrChurnStateConflict = errors.New("churn state was modified concurrently")
+
+// maxChurnStateUpdateAttempts bounds how often UpdateChurnStateWithRetry saves a state
+// whose player keeps being updated concurrently.
+const maxChurnStateUpdateAttempts = 3
+
+// UpdateChurnStateWithRetry applies update to state and saves it. If another writer saved
+// the player's state first, the state is reloaded, update is applied to the reloaded state
+// and the save is retried, so that neither write is lost. state is updated in place: on
+// success it holds the saved state, including the changes of concurrent writers.
+//
+// update may run several times and must only change the state; side effects such as AGS
+// calls belong after the save. An error returned by update aborts the save and is
+// returned unchanged.
+func UpdateChurnStateWithRetry(ctx context.Context, store StateStore, userID string, state *ChurnState, update func(*ChurnState) error) error {
+	for attempt := 1; ; attempt++ {
+		if err := update(state); err != nil {
+			return err
+		}
+
+		err := store.UpdateChurnState(ctx, userID, state)
+		if !errors.Is(err, ErrChurnStateConflict) || attempt >= maxChurnStateUpdateAttempts {
+			return err
+		}
+
+		logrus.Warnf("churn state conflict for user %s, reloading state and reapplying update (attempt %d)", userID, attempt)
+		reloaded, err := store.GetChurnState(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to reload churn state after conflict: %w", err)
+		}
+		*state = *reloaded
+	}
+}
+
 // RedisChurnStateStore implements StateStore using Redis.
 type RedisChurnStateStore struct {
 	client *redis.Client
@@ -36,14 +75,27 @@ func NewRedisChurnStateStore(
 	}
 }
 
-// makeChurnStateStoreKey creates a Redis key for a player
-func makeChurnStateStoreKey(userID string) string {
-	return fmt.Sprintf("%s%s", churnStateStoreKeyPrefix, userID)
+// makeChurnStateStoreKey creates a Redis key for a player in the namespace of ctx
+func makeChurnStateStoreKey(ctx context.Context, userID string) (string, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s", churnStateStoreKeyPrefix, key), nil
+}
+
+// observeStateStoreLatency records the latency of a state store operation started at start.
+func observeStateStoreLatency(operation string, start time.Time) {
+	metrics.StateStoreDurationSeconds.WithLabelValues(operation).Observe(time.Since(start).Seconds())
 }
 
 // GetChurnState retrieves the churn state for a player from Redis
 func (r *RedisChurnStateStore) GetChurnState(ctx context.Context, userID string) (*ChurnState, error) {
-	key := makeChurnStateStoreKey(userID)
+	defer observeStateStoreLatency("get", time.Now())
+	key, err := makeChurnStateStoreKey(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get state: %w", err)
+	}
 
 	data, err := r.client.Get(ctx, key).Result()
 	if err == redis.Nil {
@@ -75,28 +127,85 @@ func (r *RedisChurnStateStore) GetChurnState(ctx context.Context, userID string)
 	return &state, nil
 }
 
-// UpdateChurnState updates the churn state for a player in Redis
+// UpdateChurnState updates the churn state for a player in Redis.
+// The write is a compare-and-set on state.Revision using WATCH/MULTI:
+// it fails with ErrChurnStateConflict if another writer updated the state first.
 func (r *RedisChurnStateStore) UpdateChurnState(ctx context.Context, userID string, state *ChurnState) error {
-	key := makeChurnStateStoreKey(userID)
-
-	data, err := json.Marshal(state)
+	defer observeStateStoreLatency("update", time.Now())
+	key, err := makeChurnStateStoreKey(ctx, userID)
 	if err != nil {
-		logrus.Errorf("failed to marshal state for user %s: %v", userID, err)
-		return fmt.Errorf("failed to marshal state: %w", err)
+		return fmt.Errorf("failed to set state: %w", err)
 	}
-
-	if err := r.client.Set(ctx, key, data, churnStateStoreDefaultTTL).Err(); err != nil {
+	expectedRevision := state.Revision
+
+	err = r.client.Watch(ctx, func(tx *redis.Tx) error {
+		storedRevision, err := getStoredRevision(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if storedRevision != expectedRevision {
+			return ErrChurnStateConflict
+		}
+
+		next := *state
+		next.Revision = expectedRevision + 1
+		data, err := json.Marshal(&next)
+		if err != nil {
+			return fmt.Errorf("failed to marshal state: %w", err)
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, churnStateStoreDefaultTTL)
+			return nil
+		})
+		return err
+	}, key)
+
+	if errors.Is(err, redis.TxFailedErr) {
+		err = ErrChurnStateConflict
+	}
+	if errors.Is(err, ErrChurnStateConflict) {
+		logrus.Warnf("state conflict for user %s at revision %d", userID, expectedRevision)
+		return err
+	}
+	if err != nil {
 		logrus.Errorf("failed to set state for user %s: %v", userID, err)
 		return fmt.Errorf("failed to set state: %w", err)
 	}
 
-	logrus.Infof("updated state for user %s with TTL %v", userID, churnStateStoreDefaultTTL)
+	state.Revision = expectedRevision + 1
+	logrus.Infof("updated state for user %s to revision %d with TTL %v", userID, state.Revision, churnStateStoreDefaultTTL)
 	return nil
 }
 
+// getStoredRevision reads the revision of the currently stored state.
+// Returns 0 if no state exists yet.
+func getStoredRevision(ctx context.Context, tx *redis.Tx, key string) (int64, error) {
+	data, err := tx.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get state: %w", err)
+	}
+
+	var stored struct {
+		Revision int64 `json:"revision"`
+	}
+	if err := json.Unmarshal([]byte(data), &stored); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	return stored.Revision, nil
+}
+
 // DeleteChurnState deletes the churn state for a player from Redis
 func (r *RedisChurnStateStore) DeleteChurnState(ctx context.Context, userID string) error {
-	key := makeChurnStateStoreKey(userID)
+	defer observeStateStoreLatency("delete", time.Now())
+	key, err := makeChurnStateStoreKey(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete state: %w", err)
+	}
 
 	if err := r.client.Del(ctx, key).Err(); err != nil {
 		logrus.Errorf("failed to delete state for user %s: %v", userID, err)
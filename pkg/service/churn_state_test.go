@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+// testContext returns a context scoped to the test namespace, as the pipeline scopes the
+// context of each event.
+func testContext() context.Context {
+	return namespace.NewContext(context.Background(), "test-namespace", "test-namespace")
+}
+
+// newTestRedis starts a miniredis server and returns a client connected to it.
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return mr, client
+}
+
+func TestRedisChurnStateStore_ConcurrentUpdateConflict(t *testing.T) {
+	ctx := testContext()
+	_, client := newTestRedis(t)
+	store := NewRedisChurnStateStore(client, RedisChurnStateStoreConfig{})
+
+	// Two events load the same state concurrently
+	first, _ := store.GetChurnState(ctx, "test-user")
+	second, _ := store.GetChurnState(ctx, "test-user")
+
+	first.AddIntervention("first", "grant_item", "rule-a", nil, nil, time.Now())
+	if err := store.UpdateChurnState(ctx, "test-user", first); err != nil {
+		t.Fatalf("expected first update to succeed, got: %v", err)
+	}
+	if first.Revision != 1 {
+		t.Errorf("expected revision 1 after first update, got %d", first.Revision)
+	}
+
+	second.AddIntervention("second", "grant_item", "rule-b", nil, nil, time.Now())
+	err := store.UpdateChurnState(ctx, "test-user", second)
+	if !errors.Is(err, ErrChurnStateConflict) {
+		t.Fatalf("expected ErrChurnStateConflict, got: %v", err)
+	}
+	if second.Revision != 0 {
+		t.Errorf("expected the revision of a conflicting state to be kept, got %d", second.Revision)
+	}
+
+	// Saving again from the winning copy must keep working
+	if err := store.UpdateChurnState(ctx, "test-user", first); err != nil {
+		t.Fatalf("expected repeated update to succeed, got: %v", err)
+	}
+
+	stored, _ := store.GetChurnState(ctx, "test-user")
+	if stored.Revision != 2 || len(stored.InterventionHistory) != 1 {
+		t.Errorf("expected revision 2 with 1 intervention, got revision %d with %d", stored.Revision, len(stored.InterventionHistory))
+	}
+}
+
+// concurrentWriteHook writes key with another client after the revision check of a
+// WATCH transaction, right before its MULTI/EXEC, as a concurrent writer would.
+type concurrentWriteHook struct {
+	client *redis.Client
+	key    string
+	done   bool
+}
+
+func (h *concurrentWriteHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h *concurrentWriteHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	return nil
+}
+
+func (h *concurrentWriteHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	if !h.done {
+		h.done = true
+		if err := h.client.Set(ctx, h.key, `{"revision":1}`, 0).Err(); err != nil {
+			return ctx, err
+		}
+	}
+	return ctx, nil
+}
+
+func (h *concurrentWriteHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	return nil
+}
+
+func TestRedisChurnStateStore_WatchedKeyChanged(t *testing.T) {
+	ctx := testContext()
+	mr, client := newTestRedis(t)
+
+	other := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer other.Close()
+	client.AddHook(&concurrentWriteHook{client: other, key: churnStateStoreKeyPrefix + "test-user"})
+
+	store := NewRedisChurnStateStore(client, RedisChurnStateStoreConfig{})
+	state, _ := store.GetChurnState(ctx, "test-user")
+	state.AddIntervention("lost-race", "grant_item", "rule-a", nil, nil, time.Now())
+
+	// The revision check passes, but the write between WATCH and EXEC aborts the transaction
+	err := store.UpdateChurnState(ctx, "test-user", state)
+	if !errors.Is(err, ErrChurnStateConflict) {
+		t.Fatalf("expected ErrChurnStateConflict, got: %v", err)
+	}
+
+	stored, _ := store.GetChurnState(ctx, "test-user")
+	if stored.Revision != 1 || stored.GetInterventionByID("lost-race") != nil {
+		t.Errorf("expected the concurrent write to be kept, got revision %d with %+v", stored.Revision, stored.InterventionHistory)
+	}
+}
+
+func TestUpdateChurnStateWithRetry(t *testing.T) {
+	ctx := testContext()
+	_, client := newTestRedis(t)
+	store := NewRedisChurnStateStore(client, RedisChurnStateStoreConfig{})
+
+	state, _ := store.GetChurnState(ctx, "test-user")
+
+	// Another writer saves first
+	concurrent, _ := store.GetChurnState(ctx, "test-user")
+	concurrent.AddIntervention("concurrent", "grant_item", "rule-a", nil, nil, time.Now())
+	if err := store.UpdateChurnState(ctx, "test-user", concurrent); err != nil {
+		t.Fatalf("UpdateChurnState() error = %v", err)
+	}
+
+	applied := 0
+	err := UpdateChurnStateWithRetry(ctx, store, "test-user", state, func(state *ChurnState) error {
+		applied++
+		state.AddIntervention("retried", "grant_item", "rule-b", nil, nil, time.Now())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("UpdateChurnStateWithRetry() error = %v", err)
+	}
+	if applied != 2 {
+		t.Errorf("expected the update to be reapplied once, got %d applications", applied)
+	}
+
+	// Both writes are kept, and the caller's state is the saved one
+	if state.Revision != 2 || state.GetInterventionByID("concurrent") == nil || len(state.InterventionHistory) != 2 {
+		t.Errorf("expected the saved state at revision 2 with both interventions, got revision %d with %+v", state.Revision, state.InterventionHistory)
+	}
+	stored, _ := store.GetChurnState(ctx, "test-user")
+	if stored.Revision != 2 || len(stored.InterventionHistory) != 2 {
+		t.Errorf("expected revision 2 with 2 interventions stored, got revision %d with %d", stored.Revision, len(stored.InterventionHistory))
+	}
+
+	// Errors of the update abort the save
+	errSkip := errors.New("skip")
+	if err := UpdateChurnStateWithRetry(ctx, store, "test-user", state, func(*ChurnState) error { return errSkip }); err != errSkip {
+		t.Errorf("expected the update error, got %v", err)
+	}
+}
+
+func TestRedisStores_NamespaceKeys(t *testing.T) {
+	mr, client := newTestRedis(t)
+	gameA := namespace.NewContext(context.Background(), "game-a", "game-a")
+	gameB := namespace.NewContext(context.Background(), "game-b", "game-a")
+	now := time.Now()
+
+	churnStates := NewRedisChurnStateStore(client, RedisChurnStateStoreConfig{})
+	logins := NewRedisLoginSessionTrackingStore(client, RedisLoginSessionTrackingStoreConfig{})
+	sessions := NewRedisSessionTracker(client)
+	cycles := NewRedisStatCycleStore(client)
+	cooldowns := NewRedisRuleCooldownStore(client)
+	deduplicator := NewRedisEventDeduplicationStore(client, RedisEventDeduplicationStoreConfig{})
+
+	// Keys of the default namespace are not prefixed, keys of other namespaces are
+	for _, ctx := range []context.Context{gameA, gameB} {
+		state, _ := churnStates.GetChurnState(ctx, "test-user")
+		state.AddSignal("rage_quit", "high", nil, now)
+		if err := churnStates.UpdateChurnState(ctx, "test-user", state); err != nil {
+			t.Fatalf("UpdateChurnState() error = %v", err)
+		}
+		if err := logins.IncrementSessionCount(ctx, "test-user", now); err != nil {
+			t.Fatalf("IncrementSessionCount() error = %v", err)
+		}
+		if _, err := sessions.StartSession(ctx, "test-user", "session-1", now); err != nil {
+			t.Fatalf("StartSession() error = %v", err)
+		}
+		if err := cycles.SetCycleVersion(ctx, "season", 1); err != nil {
+			t.Fatalf("SetCycleVersion() error = %v", err)
+		}
+		if _, err := cooldowns.AcquireCooldown(ctx, "rule-a:test-user", time.Hour); err != nil {
+			t.Fatalf("AcquireCooldown() error = %v", err)
+		}
+		if _, err := deduplicator.AcquireEvent(ctx, "stat_item_updated", "event-1"); err != nil {
+			t.Fatalf("AcquireEvent() error = %v", err)
+		}
+	}
+
+	for _, key := range []string{
+		churnStateStoreKeyPrefix + "test-user",
+		churnStateStoreKeyPrefix + "game-b:test-user",
+		loginSessionTrackingStoreKeyPrefix + "test-user",
+		loginSessionTrackingStoreKeyPrefix + "game-b:test-user",
+		sessionTrackerKeyPrefix + "open:test-user",
+		sessionTrackerKeyPrefix + "open:game-b:test-user",
+		statCycleStoreKeyPrefix + "versions",
+		statCycleStoreKeyPrefix + "game-b:versions",
+		ruleCooldownStoreKeyPrefix + "rule-a:test-user",
+		ruleCooldownStoreKeyPrefix + "game-b:rule-a:test-user",
+		eventLeaseStoreKeyPrefix + "stat_item_updated:event-1",
+		eventLeaseStoreKeyPrefix + "game-b:stat_item_updated:event-1",
+	} {
+		if !mr.Exists(key) {
+			t.Errorf("expected key %s, got keys %v", key, mr.Keys())
+		}
+	}
+
+	// The namespaces do not see each other's data
+	if err := churnStates.DeleteChurnState(gameB, "test-user"); err != nil {
+		t.Fatalf("DeleteChurnState() error = %v", err)
+	}
+	if state, _ := churnStates.GetChurnState(gameA, "test-user"); len(state.SignalHistory) != 1 {
+		t.Errorf("expected the game-a state to be kept, got %+v", state)
+	}
+	if state, _ := churnStates.GetChurnState(gameB, "test-user"); len(state.SignalHistory) != 0 {
+		t.Errorf("expected the game-b state to be deleted, got %+v", state)
+	}
+	if err := cooldowns.ClearCooldown(gameB, "rule-a:test-user"); err != nil {
+		t.Fatalf("ClearCooldown() error = %v", err)
+	}
+	if acquired, _ := cooldowns.AcquireCooldown(gameA, "rule-a:test-user", time.Hour); acquired {
+		t.Error("expected the game-a cooldown to stay active")
+	}
+
+	// Calls without namespace fail instead of using the default namespace's keys
+	unscoped := context.Background()
+	if _, err := churnStates.GetChurnState(unscoped, "test-user"); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace from GetChurnState, got %v", err)
+	}
+	if err := logins.IncrementSessionCount(unscoped, "test-user", now); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace from IncrementSessionCount, got %v", err)
+	}
+	if _, err := sessions.StartSession(unscoped, "test-user", "session-2", now); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace from StartSession, got %v", err)
+	}
+	if _, err := cycles.GetCycleVersion(unscoped, "season"); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace from GetCycleVersion, got %v", err)
+	}
+	if _, err := cooldowns.AcquireCooldown(unscoped, "rule-b:test-user", time.Hour); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace from AcquireCooldown, got %v", err)
+	}
+	if _, err := deduplicator.AcquireEvent(unscoped, "stat_item_updated", "event-2"); !errors.Is(err, namespace.ErrNoNamespace) {
+		t.Errorf("expected ErrNoNamespace from AcquireEvent, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// eventDeduplicationStoreDefaultTTL is the default retention for processed event IDs (24 hours)
+	eventDeduplicationStoreDefaultTTL = 24 * time.Hour
+	// eventDeduplicationStoreDefaultLeaseTTL is the default processing lease (5 minutes)
+	eventDeduplicationStoreDefaultLeaseTTL = 5 * time.Minute
+	// eventDeduplicationStoreKeyPrefix is the prefix for all processed event keys
+	eventDeduplicationStoreKeyPrefix = "churn_intervention:processed_event:"
+	// eventLeaseStoreKeyPrefix is the prefix for all event processing lease keys
+	eventLeaseStoreKeyPrefix = "churn_intervention:event_lease:"
+)
+
+// acquireEventScript returns EventProcessed if the processed key KEYS[1] exists, otherwise
+// takes the lease KEYS[2] for ARGV[1] milliseconds and returns EventAcquired, or returns
+// EventInProgress if the lease is taken.
+var acquireEventScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 2
+end
+if redis.call('SET', KEYS[2], '1', 'NX', 'PX', ARGV[1]) then
+	return 0
+end
+return 1
+`)
+
+// RedisEventDeduplicationStore implements EventDeduplicator using Redis.
+// Each processed event is recorded as a key with a TTL, so the store only
+// needs to cover the window in which Kafka Connect may redeliver a message.
+// Events in progress hold a lease key that expires on its own, so that an event
+// whose processing crashed is processed on redelivery.
+type RedisEventDeduplicationStore struct {
+	client *redis.Client
+	cfg    RedisEventDeduplicationStoreConfig
+}
+
+type RedisEventDeduplicationStoreConfig struct {
+	// TTL is how long a processed event ID is remembered.
+	// Defaults to 24 hours when zero.
+	TTL time.Duration
+
+	// LeaseTTL is how long processing an event may take before a redelivery
+	// of the event is processed. Defaults to 5 minutes when zero.
+	LeaseTTL time.Duration
+}
+
+// NewRedisEventDeduplicationStore creates a new Redis-backed event deduplication store.
+func NewRedisEventDeduplicationStore(
+	client *redis.Client,
+	cfg RedisEventDeduplicationStoreConfig,
+) *RedisEventDeduplicationStore {
+	if cfg.TTL <= 0 {
+		cfg.TTL = eventDeduplicationStoreDefaultTTL
+	}
+	if cfg.LeaseTTL <= 0 {
+		cfg.LeaseTTL = eventDeduplicationStoreDefaultLeaseTTL
+	}
+
+	return &RedisEventDeduplicationStore{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+// makeEventDeduplicationStoreKey creates a Redis key for a processed event in the namespace of ctx
+func makeEventDeduplicationStoreKey(ctx context.Context, eventType, eventID string) (string, error) {
+	key, err := namespace.Key(ctx, eventType+":"+eventID)
+	if err != nil {
+		return "", err
+	}
+	return eventDeduplicationStoreKeyPrefix + key, nil
+}
+
+// makeEventLeaseStoreKey creates a Redis key for the processing lease of an event in the namespace of ctx
+func makeEventLeaseStoreKey(ctx context.Context, eventType, eventID string) (string, error) {
+	key, err := namespace.Key(ctx, eventType+":"+eventID)
+	if err != nil {
+		return "", err
+	}
+	return eventLeaseStoreKeyPrefix + key, nil
+}
+
+// makeEventStoreKeys creates the processed and lease keys of an event in the namespace of ctx
+func makeEventStoreKeys(ctx context.Context, eventType, eventID string) (processedKey, leaseKey string, err error) {
+	if processedKey, err = makeEventDeduplicationStoreKey(ctx, eventType, eventID); err != nil {
+		return "", "", err
+	}
+	if leaseKey, err = makeEventLeaseStoreKey(ctx, eventType, eventID); err != nil {
+		return "", "", err
+	}
+	return processedKey, leaseKey, nil
+}
+
+// AcquireEvent atomically checks the processed record and takes the lease of the event.
+func (r *RedisEventDeduplicationStore) AcquireEvent(ctx context.Context, eventType, eventID string) (EventStatus, error) {
+	processedKey, leaseKey, err := makeEventStoreKeys(ctx, eventType, eventID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire event: %w", err)
+	}
+
+	keys := []string{processedKey, leaseKey}
+	status, err := acquireEventScript.Run(ctx, r.client, keys, r.cfg.LeaseTTL.Milliseconds()).Int()
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire event: %w", err)
+	}
+
+	return EventStatus(status), nil
+}
+
+// CompleteEvent records the event as processed for the TTL window and releases its lease.
+func (r *RedisEventDeduplicationStore) CompleteEvent(ctx context.Context, eventType, eventID string) error {
+	processedKey, leaseKey, err := makeEventStoreKeys(ctx, eventType, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, processedKey, time.Now().Unix(), r.cfg.TTL)
+		pipe.Del(ctx, leaseKey)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseEvent removes the lease of an event so a redelivery is processed again.
+func (r *RedisEventDeduplicationStore) ReleaseEvent(ctx context.Context, eventType, eventID string) error {
+	key, err := makeEventLeaseStoreKey(ctx, eventType, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to release event: %w", err)
+	}
+
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("failed to release event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRedisEventDeduplicationStore(t *testing.T) {
+	ctx := testContext()
+	mr, client := newTestRedis(t)
+	store := NewRedisEventDeduplicationStore(client, RedisEventDeduplicationStoreConfig{LeaseTTL: time.Minute})
+
+	acquire := func(want EventStatus) {
+		t.Helper()
+		status, err := store.AcquireEvent(ctx, "stat_item_updated", "event-1")
+		if err != nil {
+			t.Fatalf("AcquireEvent() error = %v", err)
+		}
+		if status != want {
+			t.Fatalf("AcquireEvent() = %v, want %v", status, want)
+		}
+	}
+
+	acquire(EventAcquired)
+	acquire(EventInProgress)
+
+	// A failed event can be taken by its redelivery
+	if err := store.ReleaseEvent(ctx, "stat_item_updated", "event-1"); err != nil {
+		t.Fatalf("ReleaseEvent() error = %v", err)
+	}
+	acquire(EventAcquired)
+
+	// A lease whose processing was interrupted expires
+	mr.FastForward(2 * time.Minute)
+	acquire(EventAcquired)
+
+	if err := store.CompleteEvent(ctx, "stat_item_updated", "event-1"); err != nil {
+		t.Fatalf("CompleteEvent() error = %v", err)
+	}
+	acquire(EventProcessed)
+	if mr.Exists(eventLeaseStoreKeyPrefix + "stat_item_updated:event-1") {
+		t.Error("expected the lease to be released on completion")
+	}
+
+	// Processed events are remembered for the TTL only
+	mr.FastForward(eventDeduplicationStoreDefaultTTL)
+	acquire(EventAcquired)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 )
 
 // Service interfaces for external dependencies that rules/actions can use.
@@ -22,14 +23,63 @@ type UserStatisticUpdater interface {
 
 // StateStore defines the interface for accessing player churn state.
 // This allows for easier testing and different storage implementations.
+//
+// Implementations must provide optimistic concurrency on ChurnState.Revision:
+// UpdateChurnState only succeeds if the stored revision still equals state.Revision,
+// in which case the revision is incremented (both in storage and on the passed state).
+// Otherwise it returns ErrChurnStateConflict and the caller should reload and retry.
+//
+// DeleteChurnState removes the stored state; the next GetChurnState returns a new, empty state.
 type StateStore interface {
 	GetChurnState(ctx context.Context, userID string) (*ChurnState, error)
 	UpdateChurnState(ctx context.Context, userID string, state *ChurnState) error
+	DeleteChurnState(ctx context.Context, userID string) error
+}
+
+// EventStatus is the processing status of an event, as returned by EventDeduplicator.AcquireEvent.
+type EventStatus int
+
+const (
+	// EventAcquired means the caller took the processing lease and should process the event.
+	EventAcquired EventStatus = iota
+	// EventInProgress means another delivery of the event holds the processing lease.
+	EventInProgress
+	// EventProcessed means the event was completely processed, so this delivery is a duplicate.
+	EventProcessed
+)
+
+// EventDeduplicator records processed event IDs so redelivered events can be skipped.
+//
+// An event is processed under a short lease, and only recorded as processed once processing
+// completed. A duplicate delivered while the event is in progress is therefore neither
+// processed concurrently nor dropped: if the first delivery fails, the duplicate is processed.
+type EventDeduplicator interface {
+	// AcquireEvent takes the processing lease of the event, unless the event was already
+	// processed or another delivery holds the lease.
+	AcquireEvent(ctx context.Context, eventType, eventID string) (EventStatus, error)
+
+	// CompleteEvent records the event as processed and releases its lease.
+	CompleteEvent(ctx context.Context, eventType, eventID string) error
+
+	// ReleaseEvent releases the lease without recording the event as processed, so that a
+	// redelivery is processed again. This is used when processing fails.
+	ReleaseEvent(ctx context.Context, eventType, eventID string) error
+}
+
+// RuleCooldownStore persists rule trigger cooldowns so they survive restarts and span replicas.
+type RuleCooldownStore interface {
+	// AcquireCooldown atomically starts a cooldown for key lasting duration.
+	// Returns false if a cooldown for key is already active.
+	AcquireCooldown(ctx context.Context, key string, duration time.Duration) (bool, error)
+
+	// ClearCooldown ends the cooldown for key early.
+	ClearCooldown(ctx context.Context, key string) error
 }
 
 type LoginSessionTracker interface {
-	// IncrementSessionCount increments the session count for a user.
-	IncrementSessionCount(ctx context.Context, userID string) error
+	// IncrementSessionCount increments the session count for a user in the week of at,
+	// the time of the login.
+	IncrementSessionCount(ctx context.Context, userID string, at time.Time) error
 
 	// GetSessionData retrieves session tracking data for a user.
 	GetSessionData(ctx context.Context, userID string) (*SessionTrackingData, error)
@@ -37,3 +87,35 @@ type LoginSessionTracker interface {
 	// SaveSessionData saves session tracking data for a user.
 	SaveSessionData(ctx context.Context, userID string, data *SessionTrackingData) error
 }
+
+// StatCycleStore records the versions of stat cycles (e.g. seasons), so that updates
+// from a cycle that has since been reset can be told apart from current ones.
+type StatCycleStore interface {
+	// SetCycleVersion records the current version of a cycle, e.g. after it was reset.
+	// A version older than the recorded one is ignored.
+	SetCycleVersion(ctx context.Context, cycleID string, version int64) error
+
+	// GetCycleVersion returns the recorded current version of a cycle, or 0 if none is recorded.
+	GetCycleVersion(ctx context.Context, cycleID string) (int64, error)
+
+	// AdvanceUserCycleVersion records version as the latest version of a cycle seen for a
+	// player's stat and returns the version recorded before, or 0 if none.
+	// A version older than the recorded one is not recorded.
+	AdvanceUserCycleVersion(ctx context.Context, userID, cycleID, statCode string, version int64) (int64, error)
+}
+
+// SessionTracker pairs the start and end of players' sessions by session ID to measure
+// how long they play. Play time is accumulated per ISO week, like login counts.
+type SessionTracker interface {
+	// StartSession records the start of a session. Returns false if the session was
+	// already started, e.g. when its token is refreshed.
+	StartSession(ctx context.Context, userID, sessionID string, at time.Time) (bool, error)
+
+	// EndSession records the end of a session, adds its duration to the play time of the
+	// weeks it spans and returns the duration. Returns false if the start of the session
+	// is unknown, e.g. it started before tracking began or was never ended and expired.
+	EndSession(ctx context.Context, userID, sessionID string, at time.Time) (time.Duration, bool, error)
+
+	// GetWeeklyPlayTime returns the player's play time per week, keyed by yearWeek (e.g. "202610").
+	GetWeeklyPlayTime(ctx context.Context, userID string) (map[string]time.Duration, error)
+}
@@ -6,7 +6,10 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 	"github.com/go-redis/redis/v8"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
 )
 
 const (
@@ -27,17 +30,25 @@ type RedisLoginSessionTrackingStore struct {
 	cfg    RedisLoginSessionTrackingStoreConfig
 }
 
-type RedisLoginSessionTrackingStoreConfig struct{}
+type RedisLoginSessionTrackingStoreConfig struct {
+	// Clock decides which weeks are old enough to drop. Defaults to the wall clock when nil.
+	Clock clock.Clock
+}
 
 func NewRedisLoginSessionTrackingStore(client *redis.Client, cfg RedisLoginSessionTrackingStoreConfig) *RedisLoginSessionTrackingStore {
+	cfg.Clock = clock.OrReal(cfg.Clock)
 	return &RedisLoginSessionTrackingStore{
 		client: client,
 		cfg:    cfg,
 	}
 }
 
-func makeLoginSessionTrackingStoreKey(userID string) string {
-	return fmt.Sprintf("%s%s", loginSessionTrackingStoreKeyPrefix, userID)
+func makeLoginSessionTrackingStoreKey(ctx context.Context, userID string) (string, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s", loginSessionTrackingStoreKeyPrefix, key), nil
 }
 
 // getYearWeek returns the year-week string in format "YYYYWW" (e.g., "202610" for week 10 of 2026)
@@ -46,12 +57,15 @@ func getYearWeek(t time.Time) string {
 	return fmt.Sprintf("%04d%02d", year, week)
 }
 
-func (r *RedisLoginSessionTrackingStore) IncrementSessionCount(ctx context.Context, userID string) error {
-	key := makeLoginSessionTrackingStoreKey(userID)
-	yearWeek := getYearWeek(time.Now())
+func (r *RedisLoginSessionTrackingStore) IncrementSessionCount(ctx context.Context, userID string, at time.Time) error {
+	key, err := makeLoginSessionTrackingStoreKey(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to increment session count: %w", err)
+	}
+	yearWeek := getYearWeek(at)
 
 	// Atomic increment using HINCRBY
-	err := r.client.HIncrBy(ctx, key, yearWeek, 1).Err()
+	err = r.client.HIncrBy(ctx, key, yearWeek, 1).Err()
 	if err != nil {
 		return fmt.Errorf("failed to increment session count: %w", err)
 	}
@@ -60,7 +74,7 @@ func (r *RedisLoginSessionTrackingStore) IncrementSessionCount(ctx context.Conte
 	allWeeks, err := r.client.HKeys(ctx, key).Result()
 	if err == nil && len(allWeeks) > 0 {
 		// Calculate the threshold (4 weeks ago)
-		fourWeeksAgo := getYearWeek(time.Now().Add(-4 * 7 * 24 * time.Hour))
+		fourWeeksAgo := getYearWeek(r.cfg.Clock.Now().Add(-4 * 7 * 24 * time.Hour))
 
 		var toDelete []string
 		for _, week := range allWeeks {
@@ -83,7 +97,10 @@ func (r *RedisLoginSessionTrackingStore) IncrementSessionCount(ctx context.Conte
 // GetSessionData retrieves session tracking data for a user from Redis.
 // Returns new tracking data with empty map if none exists.
 func (r *RedisLoginSessionTrackingStore) GetSessionData(ctx context.Context, userID string) (*SessionTrackingData, error) {
-	key := makeLoginSessionTrackingStoreKey(userID)
+	key, err := makeLoginSessionTrackingStoreKey(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session data: %w", err)
+	}
 
 	// Get all fields from hash using HGETALL
 	data, err := r.client.HGetAll(ctx, key).Result()
@@ -110,7 +127,10 @@ func (r *RedisLoginSessionTrackingStore) GetSessionData(ctx context.Context, use
 // SaveSessionData saves session tracking data for a user to Redis.
 // Uses HSET to store the map as a hash.
 func (r *RedisLoginSessionTrackingStore) SaveSessionData(ctx context.Context, userID string, data *SessionTrackingData) error {
-	key := makeLoginSessionTrackingStoreKey(userID)
+	key, err := makeLoginSessionTrackingStoreKey(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set session data: %w", err)
+	}
 
 	// Delete existing hash first
 	r.client.Del(ctx, key)
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+)
+
+// MemoryChurnStateStore implements StateStore in memory.
+// It is meant for offline tools such as event replay: state is lost on exit and never expires.
+// Like the Redis stores, the memory stores keep the state of each namespace apart.
+// States are copied on read and write, so callers see the same isolation as with Redis.
+type MemoryChurnStateStore struct {
+	mu     sync.Mutex
+	states map[string][]byte
+}
+
+// NewMemoryChurnStateStore creates a new in-memory state store.
+func NewMemoryChurnStateStore() *MemoryChurnStateStore {
+	return &MemoryChurnStateStore{
+		states: make(map[string][]byte),
+	}
+}
+
+// GetChurnState returns a copy of the player's state, or a new state if none is stored.
+func (m *MemoryChurnStateStore) GetChurnState(ctx context.Context, userID string) (*ChurnState, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get state: %w", err)
+	}
+
+	m.mu.Lock()
+	data, ok := m.states[key]
+	m.mu.Unlock()
+
+	if !ok {
+		return &ChurnState{
+			SignalHistory:       []ChurnSignal{},
+			InterventionHistory: []InterventionRecord{},
+			Cooldown: CooldownState{
+				InterventionCounts: make(map[string]int),
+				LastSignalAt:       make(map[string]time.Time),
+			},
+		}, nil
+	}
+
+	var state ChurnState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	return &state, nil
+}
+
+// UpdateChurnState stores a copy of the state, with the same revision check as RedisChurnStateStore.
+func (m *MemoryChurnStateStore) UpdateChurnState(ctx context.Context, userID string, state *ChurnState) error {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set state: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var storedRevision int64
+	if data, ok := m.states[key]; ok {
+		var stored struct {
+			Revision int64 `json:"revision"`
+		}
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return fmt.Errorf("failed to unmarshal state: %w", err)
+		}
+		storedRevision = stored.Revision
+	}
+	if storedRevision != state.Revision {
+		return ErrChurnStateConflict
+	}
+
+	next := *state
+	next.Revision = state.Revision + 1
+	data, err := json.Marshal(&next)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	m.states[key] = data
+	state.Revision = next.Revision
+	return nil
+}
+
+// DeleteChurnState removes the player's state.
+func (m *MemoryChurnStateStore) DeleteChurnState(ctx context.Context, userID string) error {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete state: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.states, key)
+	return nil
+}
+
+// MemoryLoginSessionTrackingStore implements LoginSessionTracker in memory.
+// Like RedisLoginSessionTrackingStore, it counts logins per ISO week and keeps 4 weeks.
+type MemoryLoginSessionTrackingStore struct {
+	mu    sync.Mutex
+	clock clock.Clock
+	data  map[string]map[string]int
+}
+
+// NewMemoryLoginSessionTrackingStore creates a new in-memory login session tracking store.
+// c decides which weeks are old enough to drop; nil means the wall clock.
+func NewMemoryLoginSessionTrackingStore(c clock.Clock) *MemoryLoginSessionTrackingStore {
+	return &MemoryLoginSessionTrackingStore{
+		clock: clock.OrReal(c),
+		data:  make(map[string]map[string]int),
+	}
+}
+
+// IncrementSessionCount counts a login in the week of at and drops weeks older than 4 weeks.
+func (m *MemoryLoginSessionTrackingStore) IncrementSessionCount(ctx context.Context, userID string, at time.Time) error {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to increment session count: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loginCount, ok := m.data[key]
+	if !ok {
+		loginCount = make(map[string]int)
+		m.data[key] = loginCount
+	}
+	loginCount[getYearWeek(at)]++
+
+	fourWeeksAgo := getYearWeek(m.clock.Now().Add(-4 * 7 * 24 * time.Hour))
+	for week := range loginCount {
+		if week < fourWeeksAgo {
+			delete(loginCount, week)
+		}
+	}
+
+	return nil
+}
+
+// GetSessionData returns a copy of the player's session tracking data.
+func (m *MemoryLoginSessionTrackingStore) GetSessionData(ctx context.Context, userID string) (*SessionTrackingData, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session data: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := m.data[key]
+	loginCount := make(map[string]int, len(stored))
+	for week, count := range stored {
+		loginCount[week] = count
+	}
+
+	return &SessionTrackingData{
+		LoginCount: loginCount,
+	}, nil
+}
+
+// SaveSessionData replaces the player's session tracking data with a copy of data.
+func (m *MemoryLoginSessionTrackingStore) SaveSessionData(ctx context.Context, userID string, data *SessionTrackingData) error {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set session data: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loginCount := make(map[string]int, len(data.LoginCount))
+	for week, count := range data.LoginCount {
+		loginCount[week] = count
+	}
+	m.data[key] = loginCount
+	return nil
+}
+
+// MemoryStatCycleStore implements StatCycleStore in memory.
+type MemoryStatCycleStore struct {
+	mu       sync.Mutex
+	versions map[string]int64
+	users    map[string]int64
+}
+
+// NewMemoryStatCycleStore creates a new in-memory stat cycle store.
+func NewMemoryStatCycleStore() *MemoryStatCycleStore {
+	return &MemoryStatCycleStore{
+		versions: make(map[string]int64),
+		users:    make(map[string]int64),
+	}
+}
+
+// SetCycleVersion records the current version of a cycle unless a newer one is recorded.
+func (m *MemoryStatCycleStore) SetCycleVersion(ctx context.Context, cycleID string, version int64) error {
+	key, err := namespace.Key(ctx, cycleID)
+	if err != nil {
+		return fmt.Errorf("failed to set stat cycle version: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if version > m.versions[key] {
+		m.versions[key] = version
+	}
+	return nil
+}
+
+// GetCycleVersion returns the recorded current version of a cycle, or 0 if none is recorded.
+func (m *MemoryStatCycleStore) GetCycleVersion(ctx context.Context, cycleID string) (int64, error) {
+	key, err := namespace.Key(ctx, cycleID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get stat cycle version: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.versions[key], nil
+}
+
+// AdvanceUserCycleVersion records the latest cycle version seen for a player's stat and
+// returns the previous one.
+func (m *MemoryStatCycleStore) AdvanceUserCycleVersion(ctx context.Context, userID, cycleID, statCode string, version int64) (int64, error) {
+	key, err := namespace.Key(ctx, userID+":"+cycleID+":"+statCode)
+	if err != nil {
+		return 0, fmt.Errorf("failed to advance stat cycle version: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := m.users[key]
+	if version > previous {
+		m.users[key] = version
+	}
+	return previous, nil
+}
+
+// MemorySessionTracker implements SessionTracker in memory.
+// Like RedisSessionTracker, it accumulates play time per ISO week; nothing expires.
+type MemorySessionTracker struct {
+	mu       sync.Mutex
+	open     map[string]time.Time
+	playTime map[string]map[string]time.Duration
+}
+
+// NewMemorySessionTracker creates a new in-memory session tracker.
+func NewMemorySessionTracker() *MemorySessionTracker {
+	return &MemorySessionTracker{
+		open:     make(map[string]time.Time),
+		playTime: make(map[string]map[string]time.Duration),
+	}
+}
+
+// StartSession records the start of a session unless it was already started.
+func (m *MemorySessionTracker) StartSession(ctx context.Context, userID, sessionID string, at time.Time) (bool, error) {
+	key, err := namespace.Key(ctx, userID+":"+sessionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to start session: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.open[key]; ok {
+		return false, nil
+	}
+	m.open[key] = at.Truncate(time.Second)
+	return true, nil
+}
+
+// EndSession ends an open session and adds its duration to the weekly play time.
+func (m *MemorySessionTracker) EndSession(ctx context.Context, userID, sessionID string, at time.Time) (time.Duration, bool, error) {
+	key, err := namespace.Key(ctx, userID+":"+sessionID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to end session: %w", err)
+	}
+	playTimeKey, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to end session: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	startedAt, ok := m.open[key]
+	if !ok {
+		return 0, false, nil
+	}
+	delete(m.open, key)
+
+	playTime, ok := m.playTime[playTimeKey]
+	if !ok {
+		playTime = make(map[string]time.Duration)
+		m.playTime[playTimeKey] = playTime
+	}
+	for week, weekPlayTime := range splitSessionByWeek(startedAt, at) {
+		playTime[week] += weekPlayTime
+	}
+
+	return sessionDuration(startedAt, at), true, nil
+}
+
+// GetWeeklyPlayTime returns a copy of the player's play time per week.
+func (m *MemorySessionTracker) GetWeeklyPlayTime(ctx context.Context, userID string) (map[string]time.Duration, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get play time: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := m.playTime[key]
+	playTime := make(map[string]time.Duration, len(stored))
+	for week, duration := range stored {
+		playTime[week] = duration
+	}
+	return playTime, nil
+}
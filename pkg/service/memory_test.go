@@ -0,0 +1,158 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+)
+
+func TestMemoryChurnStateStore(t *testing.T) {
+	ctx := testContext()
+	store := NewMemoryChurnStateStore()
+
+	state, err := store.GetChurnState(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("GetChurnState() error = %v", err)
+	}
+	if state.Revision != 0 || state.Cooldown.LastSignalAt == nil {
+		t.Fatalf("expected a new initialized state, got %+v", state)
+	}
+
+	stale, _ := store.GetChurnState(ctx, "test-user")
+
+	state.AddIntervention("first", "grant_item", "rule-a", nil, nil, time.Now())
+	if err := store.UpdateChurnState(ctx, "test-user", state); err != nil {
+		t.Fatalf("UpdateChurnState() error = %v", err)
+	}
+	if state.Revision != 1 {
+		t.Errorf("expected revision 1 after update, got %d", state.Revision)
+	}
+
+	// A state read before the update conflicts, as with Redis
+	stale.AddIntervention("stale", "grant_item", "rule-b", nil, nil, time.Now())
+	if err := store.UpdateChurnState(ctx, "test-user", stale); !errors.Is(err, ErrChurnStateConflict) {
+		t.Errorf("expected ErrChurnStateConflict, got %v", err)
+	}
+
+	// Changes after the update are not visible until saved
+	state.AddIntervention("unsaved", "grant_item", "rule-a", nil, nil, time.Now())
+	stored, _ := store.GetChurnState(ctx, "test-user")
+	if stored.Revision != 1 || len(stored.InterventionHistory) != 1 {
+		t.Errorf("expected the saved state at revision 1 with 1 intervention, got revision %d with %d", stored.Revision, len(stored.InterventionHistory))
+	}
+
+	// Other namespaces have their own state
+	other := namespace.NewContext(ctx, "other-namespace", "test-namespace")
+	if state, _ := store.GetChurnState(other, "test-user"); state.Revision != 0 {
+		t.Errorf("expected no state in another namespace, got revision %d", state.Revision)
+	}
+
+	if err := store.DeleteChurnState(ctx, "test-user"); err != nil {
+		t.Fatalf("DeleteChurnState() error = %v", err)
+	}
+	if state, _ := store.GetChurnState(ctx, "test-user"); state.Revision != 0 || len(state.InterventionHistory) != 0 {
+		t.Errorf("expected a new state after delete, got %+v", state)
+	}
+}
+
+func TestMemoryLoginSessionTrackingStore(t *testing.T) {
+	ctx := testContext()
+	store := NewMemoryLoginSessionTrackingStore(nil)
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := store.IncrementSessionCount(ctx, "test-user", now); err != nil {
+			t.Fatalf("IncrementSessionCount() error = %v", err)
+		}
+	}
+
+	data, err := store.GetSessionData(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("GetSessionData() error = %v", err)
+	}
+	if data.LoginCount[getYearWeek(now)] != 2 {
+		t.Fatalf("expected 2 logins this week, got %v", data.LoginCount)
+	}
+
+	// Old weeks are dropped on the next login
+	data.LoginCount[getYearWeek(now.Add(-6*7*24*time.Hour))] = 5
+	if err := store.SaveSessionData(ctx, "test-user", data); err != nil {
+		t.Fatalf("SaveSessionData() error = %v", err)
+	}
+	data.LoginCount[getYearWeek(now)] = 100 // Not saved
+	if err := store.IncrementSessionCount(ctx, "test-user", now); err != nil {
+		t.Fatalf("IncrementSessionCount() error = %v", err)
+	}
+
+	data, _ = store.GetSessionData(ctx, "test-user")
+	if len(data.LoginCount) != 1 || data.LoginCount[getYearWeek(now)] != 3 {
+		t.Errorf("expected only this week with 3 logins, got %v", data.LoginCount)
+	}
+}
+
+func TestMemoryStatCycleStore(t *testing.T) {
+	ctx := testContext()
+	store := NewMemoryStatCycleStore()
+
+	for _, version := range []int64{2, 1} {
+		if err := store.SetCycleVersion(ctx, "cycle-1", version); err != nil {
+			t.Fatalf("SetCycleVersion() error = %v", err)
+		}
+	}
+	if version, _ := store.GetCycleVersion(ctx, "cycle-1"); version != 2 {
+		t.Errorf("expected the newest cycle version 2 to be kept, got %d", version)
+	}
+	if version, _ := store.GetCycleVersion(ctx, "cycle-2"); version != 0 {
+		t.Errorf("expected version 0 for an unknown cycle, got %d", version)
+	}
+
+	for _, tt := range []struct {
+		version  int64
+		previous int64
+	}{
+		{version: 1, previous: 0},
+		{version: 2, previous: 1},
+		{version: 1, previous: 2}, // A late event of an older cycle does not move the player back
+		{version: 2, previous: 2},
+	} {
+		previous, err := store.AdvanceUserCycleVersion(ctx, "test-user", "cycle-1", "kills", tt.version)
+		if err != nil {
+			t.Fatalf("AdvanceUserCycleVersion() error = %v", err)
+		}
+		if previous != tt.previous {
+			t.Errorf("AdvanceUserCycleVersion(%d) = %d, want %d", tt.version, previous, tt.previous)
+		}
+	}
+}
+
+func TestMemorySessionTracker(t *testing.T) {
+	ctx := testContext()
+	tracker := NewMemorySessionTracker()
+
+	start := time.Date(2025, 6, 4, 10, 0, 0, 0, time.UTC)
+	started, err := tracker.StartSession(ctx, "test-user", "session-1", start)
+	if err != nil || !started {
+		t.Fatalf("StartSession() = %v, %v, want true", started, err)
+	}
+	if started, _ := tracker.StartSession(ctx, "test-user", "session-1", start.Add(time.Minute)); started {
+		t.Error("expected a redelivered session start to be ignored")
+	}
+
+	duration, ended, err := tracker.EndSession(ctx, "test-user", "session-1", start.Add(90*time.Minute))
+	if err != nil || !ended || duration != 90*time.Minute {
+		t.Fatalf("EndSession() = %v, %v, %v, want 90m, true", duration, ended, err)
+	}
+	if _, ended, _ := tracker.EndSession(ctx, "test-user", "session-1", start.Add(2*time.Hour)); ended {
+		t.Error("expected a redelivered session end to be ignored")
+	}
+
+	playTime, err := tracker.GetWeeklyPlayTime(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("GetWeeklyPlayTime() error = %v", err)
+	}
+	if len(playTime) != 1 || playTime[getYearWeek(start)] != 90*time.Minute {
+		t.Errorf("expected 90m of play time this week, got %v", playTime)
+	}
+}
@@ -18,6 +18,14 @@ type ChurnState struct {
 	SignalHistory       []ChurnSignal        `json:"signalHistory"`
 	InterventionHistory []InterventionRecord `json:"interventionHistory"`
 	Cooldown            CooldownState        `json:"cooldown"`
+
+	// ShadowHistory records what rules and actions in shadow mode would have done.
+	// It is kept apart from InterventionHistory so shadow outcomes never affect live decisions.
+	ShadowHistory []ShadowRecord `json:"shadowHistory,omitempty"`
+
+	// Revision is the optimistic concurrency version of this state.
+	// It is set by the StateStore on read and checked on write; callers should not modify it.
+	Revision int64 `json:"revision"`
 }
 
 // ChurnSignal represents a detected churn risk signal.
@@ -43,6 +51,17 @@ type InterventionRecord struct {
 	Metadata    map[string]interface{} `json:"metadata"`    // Intervention-specific data
 }
 
+// ShadowRecord records an action that would have run had it (or its rule) not been in shadow mode.
+type ShadowRecord struct {
+	RuleID      string    `json:"ruleId"`      // Rule that triggered
+	ActionID    string    `json:"actionId"`    // Action that was not executed
+	Description string    `json:"description"` // What the action would have done
+	RecordedAt  time.Time `json:"recordedAt"`
+}
+
+// maxShadowHistory bounds ShadowHistory; the oldest records are dropped first.
+const maxShadowHistory = 50
+
 // CooldownState tracks intervention throttling to prevent spam.
 // This ensures we don't overwhelm players with too many interventions.
 type CooldownState struct {
@@ -52,18 +71,71 @@ type CooldownState struct {
 	LastSignalAt       map[string]time.Time `json:"lastSignalAt"`       // Last detection time per signal type
 }
 
-// IsOnCooldown returns whether the player is currently in a cooldown period.
-func (c *CooldownState) IsOnCooldown() bool {
-	return time.Now().Before(c.CooldownUntil)
+// IsOnCooldown returns whether the player is in a cooldown period at now.
+func (c *CooldownState) IsOnCooldown(now time.Time) bool {
+	return now.Before(c.CooldownUntil)
+}
+
+// Clone returns a deep copy of the state, for work that must not share the state with the
+// event being processed, such as async actions. Metadata maps are copied, their values are not.
+func (cs *ChurnState) Clone() *ChurnState {
+	clone := *cs
+
+	clone.SignalHistory = make([]ChurnSignal, len(cs.SignalHistory))
+	for i, sig := range cs.SignalHistory {
+		sig.Metadata = copyMetadata(sig.Metadata)
+		clone.SignalHistory[i] = sig
+	}
+
+	clone.InterventionHistory = make([]InterventionRecord, len(cs.InterventionHistory))
+	for i, intervention := range cs.InterventionHistory {
+		if intervention.ExpiresAt != nil {
+			expiresAt := *intervention.ExpiresAt
+			intervention.ExpiresAt = &expiresAt
+		}
+		if intervention.OutcomeAt != nil {
+			outcomeAt := *intervention.OutcomeAt
+			intervention.OutcomeAt = &outcomeAt
+		}
+		intervention.Metadata = copyMetadata(intervention.Metadata)
+		clone.InterventionHistory[i] = intervention
+	}
+
+	if cs.ShadowHistory != nil {
+		clone.ShadowHistory = append([]ShadowRecord(nil), cs.ShadowHistory...)
+	}
+
+	clone.Cooldown.InterventionCounts = make(map[string]int, len(cs.Cooldown.InterventionCounts))
+	for interventionType, count := range cs.Cooldown.InterventionCounts {
+		clone.Cooldown.InterventionCounts[interventionType] = count
+	}
+	clone.Cooldown.LastSignalAt = make(map[string]time.Time, len(cs.Cooldown.LastSignalAt))
+	for signalType, at := range cs.Cooldown.LastSignalAt {
+		clone.Cooldown.LastSignalAt[signalType] = at
+	}
+
+	return &clone
+}
+
+// copyMetadata returns a shallow copy of a metadata map.
+func copyMetadata(metadata map[string]interface{}) map[string]interface{} {
+	if metadata == nil {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(metadata))
+	for key, value := range metadata {
+		copied[key] = value
+	}
+	return copied
 }
 
-// GetActiveInterventions returns all interventions that are currently active.
-func (cs *ChurnState) GetActiveInterventions() []InterventionRecord {
+// GetActiveInterventions returns all interventions that are active at now.
+func (cs *ChurnState) GetActiveInterventions(now time.Time) []InterventionRecord {
 	var active []InterventionRecord
 	for _, intervention := range cs.InterventionHistory {
 		if intervention.Outcome == "active" {
 			// Check if expired
-			if intervention.ExpiresAt != nil && time.Now().After(*intervention.ExpiresAt) {
+			if intervention.ExpiresAt != nil && now.After(*intervention.ExpiresAt) {
 				continue // Expired
 			}
 			active = append(active, intervention)
@@ -82,11 +154,11 @@ func (cs *ChurnState) GetInterventionByID(id string) *InterventionRecord {
 	return nil
 }
 
-// AddSignal records a new churn signal detection.
-func (cs *ChurnState) AddSignal(signalType, severity string, metadata map[string]interface{}) {
+// AddSignal records a new churn signal detected at at.
+func (cs *ChurnState) AddSignal(signalType, severity string, metadata map[string]interface{}, at time.Time) {
 	signal := ChurnSignal{
 		Type:       signalType,
-		DetectedAt: time.Now(),
+		DetectedAt: at,
 		Severity:   severity,
 		Metadata:   metadata,
 	}
@@ -96,16 +168,28 @@ func (cs *ChurnState) AddSignal(signalType, severity string, metadata map[string
 	if cs.Cooldown.LastSignalAt == nil {
 		cs.Cooldown.LastSignalAt = make(map[string]time.Time)
 	}
-	cs.Cooldown.LastSignalAt[signalType] = time.Now()
+	cs.Cooldown.LastSignalAt[signalType] = at
 }
 
-// AddIntervention records a new intervention execution.
-func (cs *ChurnState) AddIntervention(id, interventionType, triggeredBy string, expiresAt *time.Time, metadata map[string]interface{}) {
+// ClearSignals removes the recorded signals of signalType and their last detection time.
+func (cs *ChurnState) ClearSignals(signalType string) {
+	kept := cs.SignalHistory[:0]
+	for _, signal := range cs.SignalHistory {
+		if signal.Type != signalType {
+			kept = append(kept, signal)
+		}
+	}
+	cs.SignalHistory = kept
+	delete(cs.Cooldown.LastSignalAt, signalType)
+}
+
+// AddIntervention records a new intervention executed at at.
+func (cs *ChurnState) AddIntervention(id, interventionType, triggeredBy string, expiresAt *time.Time, metadata map[string]interface{}, at time.Time) {
 	intervention := InterventionRecord{
 		ID:          id,
 		Type:        interventionType,
 		TriggeredBy: triggeredBy,
-		TriggeredAt: time.Now(),
+		TriggeredAt: at,
 		ExpiresAt:   expiresAt,
 		Outcome:     "active",
 		Metadata:    metadata,
@@ -113,21 +197,28 @@ func (cs *ChurnState) AddIntervention(id, interventionType, triggeredBy string,
 	cs.InterventionHistory = append(cs.InterventionHistory, intervention)
 
 	// Update cooldown state
-	cs.Cooldown.LastInterventionAt = time.Now()
+	cs.Cooldown.LastInterventionAt = at
 	if cs.Cooldown.InterventionCounts == nil {
 		cs.Cooldown.InterventionCounts = make(map[string]int)
 	}
 	cs.Cooldown.InterventionCounts[interventionType]++
 }
 
-// UpdateInterventionOutcome updates the outcome of an intervention.
-func (cs *ChurnState) UpdateInterventionOutcome(id, outcome string) bool {
+// UpdateInterventionOutcome updates the outcome of an intervention, decided at at.
+func (cs *ChurnState) UpdateInterventionOutcome(id, outcome string, at time.Time) bool {
 	intervention := cs.GetInterventionByID(id)
 	if intervention == nil {
 		return false
 	}
 	intervention.Outcome = outcome
-	now := time.Now()
-	intervention.OutcomeAt = &now
+	intervention.OutcomeAt = &at
 	return true
 }
+
+// AddShadowRecord appends a shadow record, dropping the oldest records beyond maxShadowHistory.
+func (cs *ChurnState) AddShadowRecord(record ShadowRecord) {
+	cs.ShadowHistory = append(cs.ShadowHistory, record)
+	if overflow := len(cs.ShadowHistory) - maxShadowHistory; overflow > 0 {
+		cs.ShadowHistory = cs.ShadowHistory[overflow:]
+	}
+}
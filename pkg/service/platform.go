@@ -10,8 +10,18 @@ import (
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/social"
 	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclient/user_statistic"
 	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclientmodels"
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
 )
 
+// requestNamespace returns the namespace of the event being processed, so that AGS is called
+// in the namespace the player belongs to, or the configured namespace outside of the pipeline.
+func requestNamespace(ctx context.Context, configured string) string {
+	if ns := namespace.FromContext(ctx); ns != "" {
+		return ns
+	}
+	return configured
+}
+
 type EntitlementService struct {
 	fulfillmentClient *platform.FulfillmentService
 	cfg               EntitlementServiceConfig
@@ -39,7 +49,7 @@ func (s *EntitlementService) GrantEntitlement(
 ) error {
 	qnty := int32(quantity)
 
-	namespace := s.cfg.Namespace
+	namespace := requestNamespace(ctx, s.cfg.Namespace)
 	fulfillmentService := s.fulfillmentClient
 
 	input := &fulfillment.FulfillItemParams{
@@ -85,7 +95,7 @@ func NewStatisticService(
 }
 
 func (s *StatisticService) UpdateStatComebackChallenge(ctx context.Context, userID string) error {
-	namespace := s.cfg.Namespace
+	namespace := requestNamespace(ctx, s.cfg.Namespace)
 	statisticsService := s.statisticsService
 
 	statCode := "rse-comeback-challenge"
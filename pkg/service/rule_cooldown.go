@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// ruleCooldownStoreKeyPrefix is the prefix for all rule cooldown keys
+	ruleCooldownStoreKeyPrefix = "churn_intervention:rule_cooldown:"
+)
+
+// RedisRuleCooldownStore implements RuleCooldownStore using Redis.
+// Each active cooldown is a key that expires when the cooldown ends, so cooldowns
+// survive restarts and are shared by all replicas.
+type RedisRuleCooldownStore struct {
+	client *redis.Client
+}
+
+// NewRedisRuleCooldownStore creates a new Redis-backed rule cooldown store.
+func NewRedisRuleCooldownStore(client *redis.Client) *RedisRuleCooldownStore {
+	return &RedisRuleCooldownStore{
+		client: client,
+	}
+}
+
+// makeRuleCooldownStoreKey creates a Redis key for a rule cooldown in the namespace of ctx
+func makeRuleCooldownStoreKey(ctx context.Context, key string) (string, error) {
+	key, err := namespace.Key(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	return ruleCooldownStoreKeyPrefix + key, nil
+}
+
+// AcquireCooldown atomically starts the cooldown using SETNX.
+// Returns false if the cooldown is already active.
+func (r *RedisRuleCooldownStore) AcquireCooldown(ctx context.Context, key string, duration time.Duration) (bool, error) {
+	key, err := makeRuleCooldownStoreKey(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire rule cooldown: %w", err)
+	}
+
+	ok, err := r.client.SetNX(ctx, key, time.Now().Unix(), duration).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire rule cooldown: %w", err)
+	}
+
+	return ok, nil
+}
+
+// ClearCooldown ends the cooldown early.
+func (r *RedisRuleCooldownStore) ClearCooldown(ctx context.Context, key string) error {
+	key, err := makeRuleCooldownStoreKey(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to clear rule cooldown: %w", err)
+	}
+
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("failed to clear rule cooldown: %w", err)
+	}
+
+	return nil
+}
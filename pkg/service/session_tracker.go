@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// sessionTrackerOpenSessionTTL bounds how long a session without an end is kept.
+	// Sessions are usually ended by a token revocation well before; the rest are abandoned.
+	sessionTrackerOpenSessionTTL = 7 * 24 * time.Hour
+
+	// sessionTrackerPlayTimeTTL is the retention of weekly play time (4 weeks, like login counts)
+	sessionTrackerPlayTimeTTL = 28 * 24 * time.Hour
+
+	// sessionTrackerKeyPrefix is the prefix for all session tracker keys
+	sessionTrackerKeyPrefix = "churn_intervention:session:"
+)
+
+// RedisSessionTracker implements SessionTracker using Redis.
+// Open sessions are kept in a hash per player mapping session IDs to their start time,
+// and play time in a hash per player mapping weeks to seconds played.
+type RedisSessionTracker struct {
+	client *redis.Client
+}
+
+// NewRedisSessionTracker creates a new Redis-backed session tracker.
+func NewRedisSessionTracker(client *redis.Client) *RedisSessionTracker {
+	return &RedisSessionTracker{
+		client: client,
+	}
+}
+
+func makeOpenSessionsKey(ctx context.Context, userID string) (string, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%sopen:%s", sessionTrackerKeyPrefix, key), nil
+}
+
+func makePlayTimeKey(ctx context.Context, userID string) (string, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%splay_time:%s", sessionTrackerKeyPrefix, key), nil
+}
+
+// StartSession records the start of a session using HSETNX, so a refresh keeps the original start.
+func (r *RedisSessionTracker) StartSession(ctx context.Context, userID, sessionID string, at time.Time) (bool, error) {
+	key, err := makeOpenSessionsKey(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to start session: %w", err)
+	}
+
+	var started *redis.BoolCmd
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		started = pipe.HSetNX(ctx, key, sessionID, at.Unix())
+		pipe.Expire(ctx, key, sessionTrackerOpenSessionTTL)
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to start session: %w", err)
+	}
+
+	return started.Val(), nil
+}
+
+// maxSessionEndAttempts bounds how often EndSession retries when the player's open sessions
+// keep changing concurrently.
+const maxSessionEndAttempts = 3
+
+// EndSession removes the open session and adds its duration to the weekly play time.
+// The session is read under WATCH and removed together with the play time update in one
+// MULTI/EXEC, so that of concurrent or redelivered ends exactly one records the session.
+func (r *RedisSessionTracker) EndSession(ctx context.Context, userID, sessionID string, at time.Time) (time.Duration, bool, error) {
+	key, err := makeOpenSessionsKey(ctx, userID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to end session: %w", err)
+	}
+	playTimeKey, err := makePlayTimeKey(ctx, userID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to end session: %w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		duration, ended, err := r.endSession(ctx, key, playTimeKey, sessionID, at)
+		// The open sessions changed concurrently, e.g. another session started: read them again
+		if errors.Is(err, redis.TxFailedErr) && attempt < maxSessionEndAttempts {
+			continue
+		}
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to end session: %w", err)
+		}
+		return duration, ended, nil
+	}
+}
+
+func (r *RedisSessionTracker) endSession(ctx context.Context, key, playTimeKey, sessionID string, at time.Time) (time.Duration, bool, error) {
+	var duration time.Duration
+	var ended bool
+
+	err := r.client.Watch(ctx, func(tx *redis.Tx) error {
+		startedAt, err := tx.HGet(ctx, key, sessionID).Int64()
+		if err == redis.Nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		weeks, err := tx.HKeys(ctx, playTimeKey).Result()
+		if err != nil {
+			return err
+		}
+		oldest := getYearWeek(at.Add(-sessionTrackerPlayTimeTTL))
+
+		start := time.Unix(startedAt, 0).In(at.Location())
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HDel(ctx, key, sessionID)
+			for week, playTime := range splitSessionByWeek(start, at) {
+				pipe.HIncrBy(ctx, playTimeKey, week, int64(playTime/time.Second))
+			}
+			// Cleanup weeks older than the retention, as the login session tracker does
+			for _, week := range weeks {
+				if week < oldest {
+					pipe.HDel(ctx, playTimeKey, week)
+				}
+			}
+			pipe.Expire(ctx, playTimeKey, sessionTrackerPlayTimeTTL)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		duration, ended = sessionDuration(start, at), true
+		return nil
+	}, key)
+
+	return duration, ended, err
+}
+
+// GetWeeklyPlayTime returns the player's play time per week.
+func (r *RedisSessionTracker) GetWeeklyPlayTime(ctx context.Context, userID string) (map[string]time.Duration, error) {
+	key, err := makePlayTimeKey(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get play time: %w", err)
+	}
+
+	data, err := r.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get play time: %w", err)
+	}
+
+	playTime := make(map[string]time.Duration, len(data))
+	for week, secondsStr := range data {
+		seconds, err := strconv.ParseInt(secondsStr, 10, 64)
+		if err != nil {
+			// Skip invalid entries
+			continue
+		}
+		playTime[week] = time.Duration(seconds) * time.Second
+	}
+
+	return playTime, nil
+}
+
+// splitSessionByWeek splits a session into the play time of each ISO week it spans, so that
+// a session crossing midnight between Sunday and Monday counts towards both weeks. The
+// shares add up to sessionDuration(start, end).
+func splitSessionByWeek(start, end time.Time) map[string]time.Duration {
+	playTime := make(map[string]time.Duration)
+	end = start.Add(sessionDuration(start, end))
+	for start.Before(end) {
+		next := startOfNextWeek(start)
+		if next.After(end) {
+			next = end
+		}
+		playTime[getYearWeek(start)] += next.Sub(start)
+		start = next
+	}
+	// A session shorter than a second still counts as played in the week it ended
+	if len(playTime) == 0 {
+		playTime[getYearWeek(end)] = 0
+	}
+	return playTime
+}
+
+// startOfNextWeek returns the midnight starting the ISO week after the one t is in.
+func startOfNextWeek(t time.Time) time.Time {
+	days := (8 - int(t.Weekday())) % 7 // Days until Monday
+	if days == 0 {
+		days = 7
+	}
+	year, month, day := t.Date()
+	return time.Date(year, month, day+days, 0, 0, 0, 0, t.Location())
+}
+
+// sessionDuration returns the time between start and end, truncated to seconds.
+// Clock skew between the events can make it negative; such sessions count as zero.
+func sessionDuration(start, end time.Time) time.Duration {
+	duration := end.Sub(start).Truncate(time.Second)
+	if duration < 0 {
+		return 0
+	}
+	return duration
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRedisSessionTracker(t *testing.T) {
+	ctx := testContext()
+	mr, client := newTestRedis(t)
+	tracker := NewRedisSessionTracker(client)
+
+	start := time.Date(2025, 6, 4, 10, 0, 0, 0, time.UTC)
+	started, err := tracker.StartSession(ctx, "test-user", "session-1", start)
+	if err != nil || !started {
+		t.Fatalf("StartSession() = %v, %v, want true", started, err)
+	}
+	if started, _ := tracker.StartSession(ctx, "test-user", "session-1", start.Add(time.Minute)); started {
+		t.Error("expected a refreshed session to keep its start")
+	}
+	if ttl := mr.TTL(sessionTrackerKeyPrefix + "open:test-user"); ttl != sessionTrackerOpenSessionTTL {
+		t.Errorf("expected open sessions to expire after %v, got %v", sessionTrackerOpenSessionTTL, ttl)
+	}
+
+	duration, ended, err := tracker.EndSession(ctx, "test-user", "session-1", start.Add(90*time.Minute))
+	if err != nil || !ended || duration != 90*time.Minute {
+		t.Fatalf("EndSession() = %v, %v, %v, want 90m, true", duration, ended, err)
+	}
+	if _, ended, _ := tracker.EndSession(ctx, "test-user", "session-1", start.Add(2*time.Hour)); ended {
+		t.Error("expected a redelivered session end to be ignored")
+	}
+	if _, ended, _ := tracker.EndSession(ctx, "test-user", "unknown", start.Add(2*time.Hour)); ended {
+		t.Error("expected the end of an unknown session to be ignored")
+	}
+
+	playTime, err := tracker.GetWeeklyPlayTime(ctx, "test-user")
+	if err != nil {
+		t.Fatalf("GetWeeklyPlayTime() error = %v", err)
+	}
+	if len(playTime) != 1 || playTime[getYearWeek(start)] != 90*time.Minute {
+		t.Errorf("expected 90m of play time this week, got %v", playTime)
+	}
+	if ttl := mr.TTL(sessionTrackerKeyPrefix + "play_time:test-user"); ttl != sessionTrackerPlayTimeTTL {
+		t.Errorf("expected play time to expire after %v, got %v", sessionTrackerPlayTimeTTL, ttl)
+	}
+}
+
+func TestRedisSessionTracker_SessionAcrossWeeks(t *testing.T) {
+	ctx := testContext()
+	_, client := newTestRedis(t)
+	tracker := NewRedisSessionTracker(client)
+
+	// Sunday 23:00 to Monday 01:30
+	start := time.Date(2025, 6, 8, 23, 0, 0, 0, time.UTC)
+	end := start.Add(150 * time.Minute)
+	if _, err := tracker.StartSession(ctx, "test-user", "session-1", start); err != nil {
+		t.Fatalf("StartSession() error = %v", err)
+	}
+	duration, ended, err := tracker.EndSession(ctx, "test-user", "session-1", end)
+	if err != nil || !ended || duration != 150*time.Minute {
+		t.Fatalf("EndSession() = %v, %v, %v, want 2h30m, true", duration, ended, err)
+	}
+
+	playTime, _ := tracker.GetWeeklyPlayTime(ctx, "test-user")
+	if playTime[getYearWeek(start)] != time.Hour || playTime[getYearWeek(end)] != 90*time.Minute {
+		t.Errorf("expected 1h in the week it started and 1h30m in the week it ended, got %v", playTime)
+	}
+}
+
+func TestRedisSessionTracker_RemovesExpiredWeeks(t *testing.T) {
+	ctx := testContext()
+	mr, client := newTestRedis(t)
+	tracker := NewRedisSessionTracker(client)
+
+	now := time.Date(2025, 6, 4, 10, 0, 0, 0, time.UTC)
+	old := getYearWeek(now.Add(-2 * sessionTrackerPlayTimeTTL))
+	mr.HSet(sessionTrackerKeyPrefix+"play_time:test-user", old, "3600")
+
+	tracker.StartSession(ctx, "test-user", "session-1", now)
+	if _, _, err := tracker.EndSession(ctx, "test-user", "session-1", now.Add(time.Hour)); err != nil {
+		t.Fatalf("EndSession() error = %v", err)
+	}
+
+	playTime, _ := tracker.GetWeeklyPlayTime(ctx, "test-user")
+	if _, ok := playTime[old]; ok || playTime[getYearWeek(now)] != time.Hour {
+		t.Errorf("expected only this week's play time to be kept, got %v", playTime)
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// statCycleStoreUserTTL is how long a player's cycle versions are kept after their last update.
+	// A player seen again after it expired is treated as seen for the first time.
+	statCycleStoreUserTTL = 180 * 24 * time.Hour
+
+	// statCycleStoreKeyPrefix is the prefix for all stat cycle keys
+	statCycleStoreKeyPrefix = "churn_intervention:stat_cycle:"
+)
+
+// advanceVersionScript sets a hash field to ARGV[2] unless it holds a higher version,
+// and returns the version it held before (0 if none). If ARGV[3] is given, the hash
+// expires ARGV[3] milliseconds later.
+var advanceVersionScript = redis.NewScript(`
+local previous = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+if tonumber(ARGV[2]) > previous then
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+end
+if ARGV[3] then
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+end
+return previous
+`)
+
+// RedisStatCycleStore implements StatCycleStore using Redis.
+// Cycle versions are kept in one hash, and the versions seen for a player in a hash
+// per player that expires when the player is inactive.
+type RedisStatCycleStore struct {
+	client *redis.Client
+}
+
+// NewRedisStatCycleStore creates a new Redis-backed stat cycle store.
+func NewRedisStatCycleStore(client *redis.Client) *RedisStatCycleStore {
+	return &RedisStatCycleStore{
+		client: client,
+	}
+}
+
+func makeStatCycleVersionsKey(ctx context.Context) (string, error) {
+	key, err := namespace.Key(ctx, "versions")
+	if err != nil {
+		return "", err
+	}
+	return statCycleStoreKeyPrefix + key, nil
+}
+
+func makeStatCycleUserKey(ctx context.Context, userID string) (string, error) {
+	key, err := namespace.Key(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%suser:%s", statCycleStoreKeyPrefix, key), nil
+}
+
+// SetCycleVersion records the current version of a cycle unless a newer one is recorded.
+func (r *RedisStatCycleStore) SetCycleVersion(ctx context.Context, cycleID string, version int64) error {
+	key, err := makeStatCycleVersionsKey(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set stat cycle version: %w", err)
+	}
+
+	if err := advanceVersionScript.Run(ctx, r.client, []string{key}, cycleID, version).Err(); err != nil {
+		return fmt.Errorf("failed to set stat cycle version: %w", err)
+	}
+
+	return nil
+}
+
+// GetCycleVersion returns the recorded current version of a cycle, or 0 if none is recorded.
+func (r *RedisStatCycleStore) GetCycleVersion(ctx context.Context, cycleID string) (int64, error) {
+	key, err := makeStatCycleVersionsKey(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get stat cycle version: %w", err)
+	}
+
+	version, err := r.client.HGet(ctx, key, cycleID).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get stat cycle version: %w", err)
+	}
+
+	return version, nil
+}
+
+// AdvanceUserCycleVersion atomically records the latest cycle version seen for a player's
+// stat, extends the retention of the player's versions, and returns the previous version.
+func (r *RedisStatCycleStore) AdvanceUserCycleVersion(ctx context.Context, userID, cycleID, statCode string, version int64) (int64, error) {
+	key, err := makeStatCycleUserKey(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to advance stat cycle version: %w", err)
+	}
+
+	previous, err := advanceVersionScript.Run(ctx, r.client, []string{key}, cycleID+":"+statCode, version, statCycleStoreUserTTL.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to advance stat cycle version: %w", err)
+	}
+
+	return previous, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+)
+
+func TestRedisStatCycleStore_CycleVersion(t *testing.T) {
+	ctx := testContext()
+	_, client := newTestRedis(t)
+	store := NewRedisStatCycleStore(client)
+
+	if version, err := store.GetCycleVersion(ctx, "season"); err != nil || version != 0 {
+		t.Fatalf("GetCycleVersion() = %d, %v, want 0 for an unknown cycle", version, err)
+	}
+
+	for _, version := range []int64{2, 1} {
+		if err := store.SetCycleVersion(ctx, "season", version); err != nil {
+			t.Fatalf("SetCycleVersion(%d) error = %v", version, err)
+		}
+	}
+
+	// An older version arriving late does not move the cycle back
+	if version, err := store.GetCycleVersion(ctx, "season"); err != nil || version != 2 {
+		t.Errorf("GetCycleVersion() = %d, %v, want 2", version, err)
+	}
+}
+
+func TestRedisStatCycleStore_AdvanceUserCycleVersion(t *testing.T) {
+	ctx := testContext()
+	mr, client := newTestRedis(t)
+	store := NewRedisStatCycleStore(client)
+
+	advance := func(statCode string, version, want int64) {
+		t.Helper()
+		previous, err := store.AdvanceUserCycleVersion(ctx, "user-1", "season", statCode, version)
+		if err != nil {
+			t.Fatalf("AdvanceUserCycleVersion() error = %v", err)
+		}
+		if previous != want {
+			t.Errorf("AdvanceUserCycleVersion(%s, %d) = %d, want %d", statCode, version, previous, want)
+		}
+	}
+
+	advance("losing-streak", 1, 0)
+	advance("losing-streak", 1, 1)
+	advance("losing-streak", 2, 1)
+	// A stale version reports the newer one seen and leaves it in place
+	advance("losing-streak", 1, 2)
+	advance("losing-streak", 2, 2)
+	// Stats of the same cycle are tracked separately
+	advance("rage-quit", 2, 0)
+
+	key := statCycleStoreKeyPrefix + "user:user-1"
+	if ttl := mr.TTL(key); ttl != statCycleStoreUserTTL {
+		t.Errorf("expected the player's versions to expire after %v, got %v", statCycleStoreUserTTL, ttl)
+	}
+
+	// A player inactive for the retention period is seen for the first time again
+	mr.FastForward(statCycleStoreUserTTL)
+	advance("losing-streak", 3, 0)
+}
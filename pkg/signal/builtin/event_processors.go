@@ -1,12 +1,19 @@
 package builtin
 
 import (
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
 )
 
 type EventProcessorDependencies struct {
 	LoginTrackingStore service.LoginSessionTracker
+	StatCycleStore     service.StatCycleStore
+	SessionTracker     service.SessionTracker
+
+	// Clock stands in for the time of events without a timestamp.
+	// Defaults to the wall clock when nil.
+	Clock clock.Clock
 }
 
 // RegisterEventProcessors registers all built-in event processors.
@@ -18,7 +25,19 @@ func RegisterEventProcessors(
 	namespace string,
 	deps *EventProcessorDependencies,
 ) {
-	registry.Register(NewOAuthEventProcessor(stateStore, deps.LoginTrackingStore, namespace))
-	registry.Register(NewRageQuitEventProcessor(stateStore, namespace))
-	registry.Register(NewLosingStreakEventProcessor(stateStore, namespace))
+	registry.Register(NewOAuthEventProcessor(stateStore, deps.LoginTrackingStore, deps.SessionTracker, namespace, deps.Clock))
+	registry.Register(NewOAuthTokenRevokedEventProcessor(stateStore, deps.SessionTracker, namespace, deps.Clock))
+	registry.Register(NewRageQuitEventProcessor(stateStore, namespace, deps.Clock))
+	registry.Register(NewLosingStreakEventProcessor(stateStore, namespace, deps.Clock))
+	registry.Register(NewStatItemCreatedEventProcessor(stateStore, namespace, deps.Clock))
+	registry.Register(NewStatItemDeletedEventProcessor(stateStore, namespace, deps.Clock))
+	registry.Register(NewStatCycleResetEventProcessor(deps.StatCycleStore))
+	registry.Register(NewStatItemCycleUpdatedEventProcessor(stateStore, deps.StatCycleStore, namespace, deps.Clock))
+}
+
+// SignalTypes returns the types of the signals emitted by the built-in event processors.
+// Rules for these types expect the built-in signal structs, so signal mappings in
+// pipeline.yaml cannot emit them.
+func SignalTypes() []string {
+	return []string{TypeLogin, TypeSessionEnded, TypeRageQuit, TypeLosingStreak, TypeStatItemCreated, TypeStatCycleUpdated, signal.TypeStatReset}
 }
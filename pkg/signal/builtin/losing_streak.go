@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
 	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
@@ -19,13 +20,15 @@ const (
 type LosingStreakEventProcessor struct {
 	stateStore service.StateStore
 	namespace  string
+	clock      clock.Clock
 }
 
 // NewLosingStreakEventProcessor creates a new losing streak event processor.
-func NewLosingStreakEventProcessor(stateStore service.StateStore, namespace string) *LosingStreakEventProcessor {
+func NewLosingStreakEventProcessor(stateStore service.StateStore, namespace string, c clock.Clock) *LosingStreakEventProcessor {
 	return &LosingStreakEventProcessor{
 		stateStore: stateStore,
 		namespace:  namespace,
+		clock:      clock.OrReal(c),
 	}
 }
 
@@ -33,6 +36,11 @@ func (p *LosingStreakEventProcessor) EventType() string {
 	return "rse-current-losing-streak"
 }
 
+// SignalType returns the type of the signals emitted by the processor.
+func (p *LosingStreakEventProcessor) SignalType() string {
+	return TypeLosingStreak
+}
+
 func (p *LosingStreakEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
 	statEvent, ok := event.(*statistic.StatItemUpdated)
 	if !ok {
@@ -48,9 +56,11 @@ func (p *LosingStreakEventProcessor) Process(ctx context.Context, event interfac
 		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
 	}
 
-	playerCtx := signal.BuildPlayerContext(userID, p.namespace, churnState)
+	at := signal.EventTime(statEvent, p.clock.Now())
+	playerCtx := signal.BuildPlayerContext(userID, signal.Namespace(ctx, p.namespace), churnState, at)
 
-	return NewLosingStreakSignal(userID, time.Now(), int(value), playerCtx), nil
+	sig := NewLosingStreakSignal(userID, at, int(value), playerCtx)
+	return sig.WithMatch(signal.AddStatItemMetadata(sig.metadata, statEvent.GetPayload())), nil
 }
 
 // LosingStreakSignal represents a player losing a match.
@@ -61,6 +71,7 @@ type LosingStreakSignal struct {
 	metadata      map[string]interface{}
 	context       *signal.PlayerContext
 	CurrentStreak int
+	MatchContext  *signal.MatchContext // nil if the stat update carries no match context
 }
 
 // NewLosingStreakSignal creates a new losing streak signal.
@@ -79,6 +90,12 @@ func NewLosingStreakSignal(userID string, timestamp time.Time, currentStreak int
 	}
 }
 
+// WithMatch sets the match the player lost and returns the signal for chaining.
+func (s *LosingStreakSignal) WithMatch(match *signal.MatchContext) *LosingStreakSignal {
+	s.MatchContext = match
+	return s
+}
+
 // Type implements Signal interface.
 func (s *LosingStreakSignal) Type() string {
 	return s.signalType
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
 	oauth "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
@@ -12,22 +13,30 @@ import (
 )
 
 // OAuthEventProcessor processes OAuth token generation events into login signals.
+// Tokens generated for a session already started, i.e. token refreshes, are not counted
+// as new sessions.
 type OAuthEventProcessor struct {
 	stateStore         service.StateStore
 	loginTrackingStore service.LoginSessionTracker
+	sessionTracker     service.SessionTracker
 	namespace          string
+	clock              clock.Clock
 }
 
 // NewOAuthEventProcessor creates a new OAuth event processor.
 func NewOAuthEventProcessor(
 	stateStore service.StateStore,
 	loginTrackingStore service.LoginSessionTracker,
+	sessionTracker service.SessionTracker,
 	namespace string,
+	c clock.Clock,
 ) *OAuthEventProcessor {
 	return &OAuthEventProcessor{
 		stateStore:         stateStore,
 		loginTrackingStore: loginTrackingStore,
+		sessionTracker:     sessionTracker,
 		namespace:          namespace,
+		clock:              clock.OrReal(c),
 	}
 }
 
@@ -35,6 +44,11 @@ func (p *OAuthEventProcessor) EventType() string {
 	return "oauth_token_generated"
 }
 
+// SignalType returns the type of the signals emitted by the processor.
+func (p *OAuthEventProcessor) SignalType() string {
+	return TypeLogin
+}
+
 func (p *OAuthEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
 	oauthEvent, ok := event.(*oauth.OauthTokenGenerated)
 	if !ok {
@@ -56,19 +70,43 @@ func (p *OAuthEventProcessor) Process(ctx context.Context, event interface{}) (s
 		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
 	}
 
+	// Logins are bucketed by when they happened, not when the event arrived
+	at := signal.EventTime(oauthEvent, p.clock.Now())
+
 	// Increment session count in rule-specific storage
 	// This is tracking telemetry for the session_decline rule
-	err = p.loginTrackingStore.IncrementSessionCount(ctx, userID)
-	if err != nil {
-		logrus.Errorf("failed to increment session count for user %s: %v", userID, err)
-		// Don't fail the signal processing if session tracking fails
+	if p.startSession(ctx, userID, oauthEvent.GetSessionId(), at) {
+		err = p.loginTrackingStore.IncrementSessionCount(ctx, userID, at)
+		if err != nil {
+			logrus.Errorf("failed to increment session count for user %s: %v", userID, err)
+			// Don't fail the signal processing if session tracking fails
+		}
 	}
 
-	playerCtx := signal.BuildPlayerContext(userID, p.namespace, churnState)
+	playerCtx := signal.BuildPlayerContext(userID, signal.Namespace(ctx, p.namespace), churnState, at)
 
 	// Create login signal
-	loginSignal := NewLoginSignal(userID, time.Now(), playerCtx)
+	loginSignal := NewLoginSignal(userID, at, playerCtx)
 
 	logrus.Debugf("processed OAuth event for user %s into LoginSignal", userID)
 	return loginSignal, nil
 }
+
+// startSession records the start of the session and reports whether it is a new session.
+// Events without a session ID are counted as new sessions, as are events whose session
+// could not be recorded.
+func (p *OAuthEventProcessor) startSession(ctx context.Context, userID, sessionID string, at time.Time) bool {
+	if sessionID == "" {
+		return true
+	}
+
+	started, err := p.sessionTracker.StartSession(ctx, userID, sessionID, at)
+	if err != nil {
+		logrus.Errorf("failed to start session %s for user %s: %v", sessionID, userID, err)
+		return true
+	}
+	if !started {
+		logrus.Debugf("token refreshed for session %s of user %s, not counted as a new session", sessionID, userID)
+	}
+	return started
+}
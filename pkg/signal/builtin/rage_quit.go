@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
 	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
@@ -19,13 +20,15 @@ const (
 type RageQuitEventProcessor struct {
 	stateStore service.StateStore
 	namespace  string
+	clock      clock.Clock
 }
 
 // NewRageQuitEventProcessor creates a new rage quit event processor.
-func NewRageQuitEventProcessor(stateStore service.StateStore, namespace string) *RageQuitEventProcessor {
+func NewRageQuitEventProcessor(stateStore service.StateStore, namespace string, c clock.Clock) *RageQuitEventProcessor {
 	return &RageQuitEventProcessor{
 		stateStore: stateStore,
 		namespace:  namespace,
+		clock:      clock.OrReal(c),
 	}
 }
 
@@ -33,6 +36,11 @@ func (p *RageQuitEventProcessor) EventType() string {
 	return "rse-rage-quit"
 }
 
+// SignalType returns the type of the signals emitted by the processor.
+func (p *RageQuitEventProcessor) SignalType() string {
+	return TypeRageQuit
+}
+
 func (p *RageQuitEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
 	statEvent, ok := event.(*statistic.StatItemUpdated)
 	if !ok {
@@ -48,9 +56,11 @@ func (p *RageQuitEventProcessor) Process(ctx context.Context, event interface{})
 		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
 	}
 
-	playerCtx := signal.BuildPlayerContext(userID, p.namespace, churnState)
+	at := signal.EventTime(statEvent, p.clock.Now())
+	playerCtx := signal.BuildPlayerContext(userID, signal.Namespace(ctx, p.namespace), churnState, at)
 
-	return NewRageQuitSignal(userID, time.Now(), int(value), playerCtx), nil
+	sig := NewRageQuitSignal(userID, at, int(value), playerCtx)
+	return sig.WithMatch(signal.AddStatItemMetadata(sig.metadata, statEvent.GetPayload())), nil
 }
 
 // RageQuitSignal represents a player rage quitting.
@@ -61,7 +71,7 @@ type RageQuitSignal struct {
 	metadata     map[string]interface{}
 	context      *signal.PlayerContext
 	QuitCount    int
-	MatchContext map[string]interface{}
+	MatchContext *signal.MatchContext // nil if the stat update carries no match context
 }
 
 // NewRageQuitSignal creates a new rage quit signal.
@@ -71,16 +81,21 @@ func NewRageQuitSignal(userID string, timestamp time.Time, quitCount int, contex
 		"stat_code":  "rse-rage-quit",
 	}
 	return &RageQuitSignal{
-		signalType:   TypeRageQuit,
-		userID:       userID,
-		timestamp:    timestamp,
-		metadata:     metadata,
-		context:      context,
-		QuitCount:    quitCount,
-		MatchContext: make(map[string]interface{}),
+		signalType: TypeRageQuit,
+		userID:     userID,
+		timestamp:  timestamp,
+		metadata:   metadata,
+		context:    context,
+		QuitCount:  quitCount,
 	}
 }
 
+// WithMatch sets the match the player quit and returns the signal for chaining.
+func (s *RageQuitSignal) WithMatch(match *signal.MatchContext) *RageQuitSignal {
+	s.MatchContext = match
+	return s
+}
+
 // Type implements Signal interface.
 func (s *RageQuitSignal) Type() string {
 	return s.signalType
@@ -0,0 +1,172 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	oauth "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// EventTypeOAuthTokenRevoked routes OAuth token revocation (logout) events
+	EventTypeOAuthTokenRevoked = "oauth_token_revoked"
+
+	// TypeSessionEnded is the signal type emitted when a player's session ends
+	TypeSessionEnded = "session_ended"
+)
+
+// OAuthTokenRevokedEventProcessor processes OAuth token revocation events, i.e. logouts,
+// into session ended signals. The session is paired with its login by session ID;
+// revocations of sessions whose login was not seen emit no signal.
+type OAuthTokenRevokedEventProcessor struct {
+	stateStore     service.StateStore
+	sessionTracker service.SessionTracker
+	namespace      string
+	clock          clock.Clock
+}
+
+// NewOAuthTokenRevokedEventProcessor creates a new OAuth token revoked event processor.
+func NewOAuthTokenRevokedEventProcessor(
+	stateStore service.StateStore,
+	sessionTracker service.SessionTracker,
+	namespace string,
+	c clock.Clock,
+) *OAuthTokenRevokedEventProcessor {
+	return &OAuthTokenRevokedEventProcessor{
+		stateStore:     stateStore,
+		sessionTracker: sessionTracker,
+		namespace:      namespace,
+		clock:          clock.OrReal(c),
+	}
+}
+
+func (p *OAuthTokenRevokedEventProcessor) EventType() string {
+	return EventTypeOAuthTokenRevoked
+}
+
+// SignalType returns the type of the signals emitted by the processor.
+func (p *OAuthTokenRevokedEventProcessor) SignalType() string {
+	return TypeSessionEnded
+}
+
+func (p *OAuthTokenRevokedEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
+	oauthEvent, ok := event.(*oauth.OauthTokenRevoked)
+	if !ok {
+		return nil, fmt.Errorf("expected *oauth.OauthTokenRevoked, got %T", event)
+	}
+
+	userID := oauthEvent.GetUserId()
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is empty in oauth event")
+	}
+
+	sessionID := oauthEvent.GetSessionId()
+	if sessionID == "" {
+		logrus.Debugf("token revoked for user %s without session ID, session duration unknown", userID)
+		return nil, nil
+	}
+
+	at := signal.EventTime(oauthEvent, p.clock.Now())
+	duration, ok, err := p.sessionTracker.EndSession(ctx, userID, sessionID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to end session %s for user %s: %w", sessionID, userID, err)
+	}
+	if !ok {
+		logrus.Debugf("token revoked for unknown session %s of user %s, session duration unknown", sessionID, userID)
+		return nil, nil
+	}
+
+	playTime, err := p.sessionTracker.GetWeeklyPlayTime(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get play time for user %s: %w", userID, err)
+	}
+
+	churnState, err := p.stateStore.GetChurnState(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
+	}
+
+	playerCtx := signal.BuildPlayerContext(userID, signal.Namespace(ctx, p.namespace), churnState, at)
+
+	logrus.Debugf("processed OAuth token revoked event for user %s into SessionEndedSignal (%v)", userID, duration)
+	return NewSessionEndedSignal(userID, at, sessionID, duration,
+		playTime[getYearWeek(at)], playTime[getYearWeek(at.Add(-7*24*time.Hour))], playerCtx), nil
+}
+
+// getYearWeek returns the year-week string in format "YYYYWW", the key of the weekly play time
+func getYearWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%04d%02d", year, week)
+}
+
+// SessionEndedSignal represents the end of a player's session.
+// Besides the duration of the session, it carries the player's play time this week and
+// last week, so that rules can detect shrinking play time.
+type SessionEndedSignal struct {
+	signalType       string
+	userID           string
+	timestamp        time.Time
+	metadata         map[string]interface{}
+	context          *signal.PlayerContext
+	SessionID        string
+	Duration         time.Duration
+	PlayTimeThisWeek time.Duration
+	PlayTimeLastWeek time.Duration
+}
+
+// NewSessionEndedSignal creates a new session ended signal.
+func NewSessionEndedSignal(
+	userID string,
+	timestamp time.Time,
+	sessionID string,
+	duration, playTimeThisWeek, playTimeLastWeek time.Duration,
+	context *signal.PlayerContext,
+) *SessionEndedSignal {
+	metadata := map[string]interface{}{
+		"session_id":                  sessionID,
+		"duration_seconds":            duration.Seconds(),
+		"play_time_this_week_seconds": playTimeThisWeek.Seconds(),
+		"play_time_last_week_seconds": playTimeLastWeek.Seconds(),
+	}
+	return &SessionEndedSignal{
+		signalType:       TypeSessionEnded,
+		userID:           userID,
+		timestamp:        timestamp,
+		metadata:         metadata,
+		context:          context,
+		SessionID:        sessionID,
+		Duration:         duration,
+		PlayTimeThisWeek: playTimeThisWeek,
+		PlayTimeLastWeek: playTimeLastWeek,
+	}
+}
+
+// Type implements Signal interface.
+func (s *SessionEndedSignal) Type() string {
+	return s.signalType
+}
+
+// UserID implements Signal interface.
+func (s *SessionEndedSignal) UserID() string {
+	return s.userID
+}
+
+// Timestamp implements Signal interface.
+func (s *SessionEndedSignal) Timestamp() time.Time {
+	return s.timestamp
+}
+
+// Metadata implements Signal interface.
+func (s *SessionEndedSignal) Metadata() map[string]interface{} {
+	return s.metadata
+}
+
+// Context implements Signal interface.
+func (s *SessionEndedSignal) Context() *signal.PlayerContext {
+	return s.context
+}
@@ -0,0 +1,108 @@
+package builtin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	oauth "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+)
+
+func TestOAuthEventProcessor_TokenRefreshNotCounted(t *testing.T) {
+	ctx := testContext()
+	virtual := clock.NewVirtual(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+	loginStore := service.NewMemoryLoginSessionTrackingStore(virtual)
+	p := NewOAuthEventProcessor(service.NewMemoryChurnStateStore(), loginStore, service.NewMemorySessionTracker(), "test", virtual)
+
+	// The same session generating tokens twice is a login followed by a refresh
+	for _, sessionID := range []string{"session-1", "session-1", "session-2", ""} {
+		if _, err := p.Process(ctx, &oauth.OauthTokenGenerated{UserId: "user123", SessionId: sessionID}); err != nil {
+			t.Fatalf("Process() error = %v", err)
+		}
+	}
+
+	data, err := loginStore.GetSessionData(ctx, "user123")
+	if err != nil {
+		t.Fatalf("GetSessionData() error = %v", err)
+	}
+	if count := data.LoginCount[getYearWeek(virtual.Now())]; count != 3 {
+		t.Errorf("expected 3 sessions counted (2 sessions and 1 without ID), got %d", count)
+	}
+}
+
+func TestOAuthTokenRevokedEventProcessor(t *testing.T) {
+	ctx := testContext()
+	virtual := clock.NewVirtual(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+
+	stateStore := service.NewMemoryChurnStateStore()
+	sessionTracker := service.NewMemorySessionTracker()
+	login := NewOAuthEventProcessor(stateStore, service.NewMemoryLoginSessionTrackingStore(virtual), sessionTracker, "test", virtual)
+	logout := NewOAuthTokenRevokedEventProcessor(stateStore, sessionTracker, "test", virtual)
+
+	if _, err := login.Process(ctx, &oauth.OauthTokenGenerated{UserId: "user123", SessionId: "session-1"}); err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	virtual.Advance(30 * time.Minute)
+	// A refresh does not restart the session
+	if _, err := login.Process(ctx, &oauth.OauthTokenGenerated{UserId: "user123", SessionId: "session-1"}); err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	virtual.Advance(15 * time.Minute)
+
+	sig, err := logout.Process(ctx, &oauth.OauthTokenRevoked{UserId: "user123", SessionId: "session-1"})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	ended, ok := sig.(*SessionEndedSignal)
+	if !ok {
+		t.Fatalf("expected *SessionEndedSignal, got %T", sig)
+	}
+	if ended.Type() != TypeSessionEnded || ended.Duration != 45*time.Minute {
+		t.Errorf("expected %s signal of 45m, got %s of %v", TypeSessionEnded, ended.Type(), ended.Duration)
+	}
+	if ended.PlayTimeThisWeek != 45*time.Minute || ended.PlayTimeLastWeek != 0 {
+		t.Errorf("unexpected play time: this week %v, last week %v", ended.PlayTimeThisWeek, ended.PlayTimeLastWeek)
+	}
+	if ended.Metadata()["duration_seconds"] != 2700.0 {
+		t.Errorf("unexpected metadata %v", ended.Metadata())
+	}
+
+	// Revoking the session again, or an unknown one, emits no signal
+	for _, sessionID := range []string{"session-1", "unknown", ""} {
+		sig, err := logout.Process(ctx, &oauth.OauthTokenRevoked{UserId: "user123", SessionId: sessionID})
+		if err != nil {
+			t.Fatalf("Process() error = %v", err)
+		}
+		if sig != nil {
+			t.Errorf("expected no signal for session %q, got %v", sessionID, sig)
+		}
+	}
+}
+
+func TestOAuthEventProcessor_LoginsBucketedByEventTime(t *testing.T) {
+	ctx := testContext()
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	virtual := clock.NewVirtual(now)
+
+	loginStore := service.NewMemoryLoginSessionTrackingStore(virtual)
+	p := NewOAuthEventProcessor(service.NewMemoryChurnStateStore(), loginStore, service.NewMemorySessionTracker(), "test", virtual)
+
+	// A login from last week arriving late counts in last week
+	lastWeek := now.Add(-7 * 24 * time.Hour)
+	sig, err := p.Process(ctx, &oauth.OauthTokenGenerated{UserId: "user123", Timestamp: lastWeek.Format(time.RFC3339)})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if !sig.Timestamp().Equal(lastWeek) {
+		t.Errorf("expected signal stamped with the event time %v, got %v", lastWeek, sig.Timestamp())
+	}
+
+	data, err := loginStore.GetSessionData(ctx, "user123")
+	if err != nil {
+		t.Fatalf("GetSessionData() error = %v", err)
+	}
+	if data.LoginCount[getYearWeek(lastWeek)] != 1 || data.LoginCount[getYearWeek(now)] != 0 {
+		t.Errorf("expected the login counted in week %s, got %v", getYearWeek(lastWeek), data.LoginCount)
+	}
+}
@@ -1,13 +1,23 @@
 package builtin
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// testContext returns a context scoped to the test namespace, as the pipeline scopes the
+// context of each event.
+func testContext() context.Context {
+	return namespace.NewContext(context.Background(), "test-namespace", "test-namespace")
+}
+
 func TestLoginSignal(t *testing.T) {
 	timestamp := time.Now()
 	playerCtx := &signal.PlayerContext{
@@ -51,8 +61,29 @@ func TestRageQuitSignal(t *testing.T) {
 		t.Errorf("Expected metadata quit_count=5")
 	}
 
-	if sig.MatchContext == nil {
-		t.Error("Expected non-nil MatchContext")
+	if sig.MatchContext != nil {
+		t.Error("Expected nil MatchContext without a match context")
+	}
+}
+
+func TestRageQuitEventProcessor_MatchContext(t *testing.T) {
+	data, _ := structpb.NewStruct(map[string]interface{}{"match_id": "match-42", "mode": "ranked"})
+	p := NewRageQuitEventProcessor(service.NewMemoryChurnStateStore(), "test", nil)
+
+	sig, err := p.Process(testContext(), &statistic.StatItemUpdated{
+		UserId:  "user123",
+		Payload: &statistic.StatItem{StatCode: "rse-rage-quit", LatestValue: 3, AdditionalData: data},
+	})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	rageQuit := sig.(*RageQuitSignal)
+	if rageQuit.MatchContext == nil || rageQuit.MatchContext.MatchID != "match-42" || rageQuit.MatchContext.Data["mode"] != "ranked" {
+		t.Errorf("expected the match context decoded, got %+v", rageQuit.MatchContext)
+	}
+	if sig.Metadata()[signal.MetadataMatchMode] != "ranked" {
+		t.Errorf("expected match_mode metadata, got %v", sig.Metadata())
 	}
 }
 
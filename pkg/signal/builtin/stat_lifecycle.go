@@ -0,0 +1,264 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+	"github.com/sirupsen/logrus"
+)
+
+// Event types of the stat lifecycle events, used to route them to their event processors.
+const (
+	EventTypeStatItemCreated      = "stat_item_created"
+	EventTypeStatItemDeleted      = "stat_item_deleted"
+	EventTypeStatCycleReset       = "stat_cycle_reset"
+	EventTypeStatItemCycleUpdated = "stat_item_cycle_updated"
+)
+
+// Signal type constants for stat lifecycle signals
+const (
+	TypeStatItemCreated  = "stat_item_created"
+	TypeStatCycleUpdated = "stat_cycle_updated"
+)
+
+// Metadata keys of stat cycle signals
+const (
+	MetadataCycleID      = "cycle_id"
+	MetadataCycleVersion = "cycle_version"
+)
+
+// StatItemCreatedEventProcessor processes the creation of a player's stat item, i.e. the
+// first update of a stat for the player, into a "stat_item_created" signal. Rules for new
+// players can match it, narrowed to a stat code with a condition on signal.stat_code.
+type StatItemCreatedEventProcessor struct {
+	stateStore service.StateStore
+	namespace  string
+	clock      clock.Clock
+}
+
+// NewStatItemCreatedEventProcessor creates a new stat item created event processor.
+func NewStatItemCreatedEventProcessor(stateStore service.StateStore, namespace string, c clock.Clock) *StatItemCreatedEventProcessor {
+	return &StatItemCreatedEventProcessor{
+		stateStore: stateStore,
+		namespace:  namespace,
+		clock:      clock.OrReal(c),
+	}
+}
+
+func (p *StatItemCreatedEventProcessor) EventType() string {
+	return EventTypeStatItemCreated
+}
+
+// SignalType returns the type of the signals emitted by the processor.
+func (p *StatItemCreatedEventProcessor) SignalType() string {
+	return TypeStatItemCreated
+}
+
+func (p *StatItemCreatedEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
+	statEvent, ok := event.(*statistic.StatItemCreated)
+	if !ok {
+		return nil, fmt.Errorf("expected *statistic.StatItemCreated, got %T", event)
+	}
+
+	payload := statEvent.GetPayload()
+	userID, err := statItemUserID(statEvent.GetUserId(), payload.GetUserId(), payload.GetStatCode())
+	if err != nil {
+		return nil, err
+	}
+
+	churnState, err := p.stateStore.GetChurnState(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
+	}
+
+	at := signal.EventTime(statEvent, p.clock.Now())
+	playerCtx := signal.BuildPlayerContext(userID, signal.Namespace(ctx, p.namespace), churnState, at)
+	sig := signal.NewStatSignal(TypeStatItemCreated, userID, at, payload.GetStatCode(), payload.GetLatestValue(), playerCtx)
+	signal.AddStatItemMetadata(sig.Metadata(), payload)
+	return sig, nil
+}
+
+// StatItemDeletedEventProcessor processes the deletion of a player's stat item into a
+// stat reset signal.
+type StatItemDeletedEventProcessor struct {
+	stateStore service.StateStore
+	namespace  string
+	clock      clock.Clock
+}
+
+// NewStatItemDeletedEventProcessor creates a new stat item deleted event processor.
+func NewStatItemDeletedEventProcessor(stateStore service.StateStore, namespace string, c clock.Clock) *StatItemDeletedEventProcessor {
+	return &StatItemDeletedEventProcessor{
+		stateStore: stateStore,
+		namespace:  namespace,
+		clock:      clock.OrReal(c),
+	}
+}
+
+func (p *StatItemDeletedEventProcessor) EventType() string {
+	return EventTypeStatItemDeleted
+}
+
+func (p *StatItemDeletedEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
+	statEvent, ok := event.(*statistic.StatItemDeleted)
+	if !ok {
+		return nil, fmt.Errorf("expected *statistic.StatItemDeleted, got %T", event)
+	}
+
+	payload := statEvent.GetPayload()
+	userID, err := statItemUserID(statEvent.GetUserId(), payload.GetUserId(), payload.GetStatCode())
+	if err != nil {
+		return nil, err
+	}
+
+	churnState, err := p.stateStore.GetChurnState(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
+	}
+
+	at := signal.EventTime(statEvent, p.clock.Now())
+	playerCtx := signal.BuildPlayerContext(userID, signal.Namespace(ctx, p.namespace), churnState, at)
+	return signal.NewStatResetSignal(userID, at, payload.GetStatCode(), signal.StatResetDeleted, playerCtx), nil
+}
+
+// StatCycleResetEventProcessor records the new version of a stat cycle (e.g. a season)
+// when it is reset. The event concerns all players, so it emits no signal; players are
+// reset as their first update in the new cycle arrives (see StatItemCycleUpdatedEventProcessor).
+type StatCycleResetEventProcessor struct {
+	cycleStore service.StatCycleStore
+}
+
+// NewStatCycleResetEventProcessor creates a new stat cycle reset event processor.
+func NewStatCycleResetEventProcessor(cycleStore service.StatCycleStore) *StatCycleResetEventProcessor {
+	return &StatCycleResetEventProcessor{
+		cycleStore: cycleStore,
+	}
+}
+
+func (p *StatCycleResetEventProcessor) EventType() string {
+	return EventTypeStatCycleReset
+}
+
+func (p *StatCycleResetEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
+	cycleEvent, ok := event.(*statistic.StatCycleReset)
+	if !ok {
+		return nil, fmt.Errorf("expected *statistic.StatCycleReset, got %T", event)
+	}
+
+	cycle := cycleEvent.GetPayload()
+	if cycle.GetId() == "" {
+		return nil, fmt.Errorf("cycle ID is empty in stat cycle reset event")
+	}
+
+	if err := p.cycleStore.SetCycleVersion(ctx, cycle.GetId(), cycle.GetCurrentVersion()); err != nil {
+		return nil, fmt.Errorf("failed to record version of stat cycle %s: %w", cycle.GetId(), err)
+	}
+
+	logrus.Infof("stat cycle %s (%s) was reset to version %d", cycle.GetId(), cycle.GetCycleType(), cycle.GetCurrentVersion())
+	return nil, nil
+}
+
+// StatItemCycleUpdatedEventProcessor processes updates of a player's stat within a stat
+// cycle into "stat_cycle_updated" signals. Updates from an older version of the cycle than
+// one already seen are stale and dropped. The first update of a player's stat in a new
+// version of the cycle is emitted as a stat reset signal carrying the update, so that what
+// was derived from the previous cycle, e.g. a losing streak cooldown, is cleared before the
+// update is evaluated.
+type StatItemCycleUpdatedEventProcessor struct {
+	stateStore service.StateStore
+	cycleStore service.StatCycleStore
+	namespace  string
+	clock      clock.Clock
+}
+
+// NewStatItemCycleUpdatedEventProcessor creates a new stat item cycle updated event processor.
+func NewStatItemCycleUpdatedEventProcessor(stateStore service.StateStore, cycleStore service.StatCycleStore, namespace string, c clock.Clock) *StatItemCycleUpdatedEventProcessor {
+	return &StatItemCycleUpdatedEventProcessor{
+		stateStore: stateStore,
+		cycleStore: cycleStore,
+		namespace:  namespace,
+		clock:      clock.OrReal(c),
+	}
+}
+
+func (p *StatItemCycleUpdatedEventProcessor) EventType() string {
+	return EventTypeStatItemCycleUpdated
+}
+
+// SignalType returns the type of the signals emitted by the processor for current updates.
+func (p *StatItemCycleUpdatedEventProcessor) SignalType() string {
+	return TypeStatCycleUpdated
+}
+
+func (p *StatItemCycleUpdatedEventProcessor) Process(ctx context.Context, event interface{}) (signal.Signal, error) {
+	cycleEvent, ok := event.(*statistic.StatItemCycleUpdated)
+	if !ok {
+		return nil, fmt.Errorf("expected *statistic.StatItemCycleUpdated, got %T", event)
+	}
+
+	item := cycleEvent.GetPayload()
+	userID, err := statItemUserID(cycleEvent.GetUserId(), item.GetUserId(), item.GetStatCode())
+	if err != nil {
+		return nil, err
+	}
+	cycleID, version := item.GetCycleId(), item.GetCycleVersion()
+
+	currentVersion, err := p.cycleStore.GetCycleVersion(ctx, cycleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version of stat cycle %s: %w", cycleID, err)
+	}
+	if version < currentVersion {
+		logrus.Infof("dropping stale update of stat %s for user %s: cycle %s version %d, current version %d",
+			item.GetStatCode(), userID, cycleID, version, currentVersion)
+		return nil, nil
+	}
+
+	previousVersion, err := p.cycleStore.AdvanceUserCycleVersion(ctx, userID, cycleID, item.GetStatCode(), version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record version of stat cycle %s for user %s: %w", cycleID, userID, err)
+	}
+	if version < previousVersion {
+		logrus.Infof("dropping stale update of stat %s for user %s: cycle %s version %d, already seen version %d",
+			item.GetStatCode(), userID, cycleID, version, previousVersion)
+		return nil, nil
+	}
+
+	churnState, err := p.stateStore.GetChurnState(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
+	}
+	at := signal.EventTime(cycleEvent, p.clock.Now())
+	playerCtx := signal.BuildPlayerContext(userID, signal.Namespace(ctx, p.namespace), churnState, at)
+
+	sig := signal.NewStatSignal(TypeStatCycleUpdated, userID, at, item.GetStatCode(), item.GetLatestValue(), playerCtx)
+	sig.Metadata()[MetadataCycleID] = cycleID
+	sig.Metadata()[MetadataCycleVersion] = version
+
+	if previousVersion > 0 && version > previousVersion {
+		reset := signal.NewStatResetSignal(userID, at, item.GetStatCode(), signal.StatResetCycle, playerCtx)
+		reset.Metadata()[MetadataCycleID] = cycleID
+		reset.Metadata()[MetadataCycleVersion] = version
+		reset.Next = sig
+		return reset, nil
+	}
+
+	return sig, nil
+}
+
+// statItemUserID returns the user ID of a stat event, falling back to the payload user ID.
+func statItemUserID(eventUserID, payloadUserID, statCode string) (string, error) {
+	if statCode == "" {
+		return "", fmt.Errorf("stat code is empty in stat event")
+	}
+	if eventUserID != "" {
+		return eventUserID, nil
+	}
+	if payloadUserID != "" {
+		return payloadUserID, nil
+	}
+	return "", fmt.Errorf("user ID is empty in stat event")
+}
@@ -0,0 +1,109 @@
+package builtin
+
+import (
+	"testing"
+
+	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"github.com/AccelByte/extend-churn-intervention/pkg/signal"
+)
+
+func TestStatItemCreatedEventProcessor(t *testing.T) {
+	p := NewStatItemCreatedEventProcessor(service.NewMemoryChurnStateStore(), "test", nil)
+
+	sig, err := p.Process(testContext(), &statistic.StatItemCreated{
+		Payload: &statistic.StatItem{UserId: "user123", StatCode: "rse-match-wins", LatestValue: 1},
+	})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	if sig.Type() != TypeStatItemCreated || sig.UserID() != "user123" {
+		t.Errorf("expected %s signal for user123, got %s for %s", TypeStatItemCreated, sig.Type(), sig.UserID())
+	}
+	if sig.Metadata()[signal.MetadataStatCode] != "rse-match-wins" || sig.Metadata()[signal.MetadataValue] != 1.0 {
+		t.Errorf("unexpected metadata %v", sig.Metadata())
+	}
+}
+
+func TestStatItemDeletedEventProcessor(t *testing.T) {
+	p := NewStatItemDeletedEventProcessor(service.NewMemoryChurnStateStore(), "test", nil)
+
+	sig, err := p.Process(testContext(), &statistic.StatItemDeleted{
+		UserId:  "user123",
+		Payload: &statistic.StatItem{StatCode: "rse-current-losing-streak"},
+	})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	reset, ok := sig.(*signal.StatResetSignal)
+	if !ok {
+		t.Fatalf("expected *signal.StatResetSignal, got %T", sig)
+	}
+	if reset.StatCode != "rse-current-losing-streak" || reset.Reason != signal.StatResetDeleted {
+		t.Errorf("unexpected reset of %s (%s)", reset.StatCode, reset.Reason)
+	}
+
+	if _, err := p.Process(testContext(), &statistic.StatItemDeleted{
+		Payload: &statistic.StatItem{StatCode: "rse-current-losing-streak"},
+	}); err == nil {
+		t.Error("expected an error for an event without user ID")
+	}
+}
+
+func TestStatCycleEventProcessors(t *testing.T) {
+	ctx := testContext()
+	cycleStore := service.NewMemoryStatCycleStore()
+	resetProcessor := NewStatCycleResetEventProcessor(cycleStore)
+	updateProcessor := NewStatItemCycleUpdatedEventProcessor(service.NewMemoryChurnStateStore(), cycleStore, "test", nil)
+
+	update := func(version int64) signal.Signal {
+		t.Helper()
+		sig, err := updateProcessor.Process(ctx, &statistic.StatItemCycleUpdated{
+			Payload: &statistic.StatCycleItem{
+				UserId:       "user123",
+				CycleId:      "season",
+				StatCode:     "rse-current-losing-streak",
+				CycleVersion: version,
+				LatestValue:  2,
+			},
+		})
+		if err != nil {
+			t.Fatalf("Process() error = %v", err)
+		}
+		return sig
+	}
+
+	if sig := update(1); sig == nil || sig.Type() != TypeStatCycleUpdated {
+		t.Fatalf("expected a %s signal for the first update, got %v", TypeStatCycleUpdated, sig)
+	}
+
+	sig, err := resetProcessor.Process(ctx, &statistic.StatCycleReset{
+		Payload: &statistic.StatCycle{Id: "season", CurrentVersion: 2},
+	})
+	if err != nil || sig != nil {
+		t.Fatalf("expected no signal and no error for a cycle reset, got %v, %v", sig, err)
+	}
+
+	if sig := update(1); sig != nil {
+		t.Errorf("expected an update from the reset cycle version to be dropped, got %s", sig.Type())
+	}
+
+	sig = update(2)
+	if sig == nil || sig.Type() != signal.TypeStatReset {
+		t.Fatalf("expected a %s signal for the first update in the new cycle, got %v", signal.TypeStatReset, sig)
+	}
+	if sig.Metadata()[signal.MetadataReason] != signal.StatResetCycle || sig.Metadata()[MetadataCycleVersion] != int64(2) {
+		t.Errorf("unexpected metadata %v", sig.Metadata())
+	}
+	// The update itself is still evaluated after the reset
+	next := sig.(*signal.StatResetSignal).Next
+	if next == nil || next.Type() != TypeStatCycleUpdated || next.Metadata()[signal.MetadataValue] != float64(2) {
+		t.Errorf("expected the reset to carry the %s signal of the update, got %v", TypeStatCycleUpdated, next)
+	}
+
+	if sig := update(2); sig == nil || sig.Type() != TypeStatCycleUpdated {
+		t.Errorf("expected a %s signal for later updates in the cycle, got %v", TypeStatCycleUpdated, sig)
+	}
+}
@@ -18,6 +18,12 @@ type EventProcessor interface {
 	Process(ctx context.Context, event interface{}) (Signal, error)
 }
 
+// SignalTyper is implemented by event processors that emit signals of a single type.
+// It lets the pipeline find the rules fed by a stat code, e.g. when the stat is reset.
+type SignalTyper interface {
+	SignalType() string
+}
+
 // EventProcessorRegistry manages registered event processors.
 type EventProcessorRegistry struct {
 	mu         sync.RWMutex
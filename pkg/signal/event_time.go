@@ -0,0 +1,31 @@
+package signal
+
+import (
+	"time"
+)
+
+// ParseEventTime returns when an AGS event occurred, from its RFC 3339 "timestamp" field.
+// Returns false if the event has no timestamp or it is invalid.
+func ParseEventTime(event interface{}) (time.Time, bool) {
+	e, ok := event.(interface{ GetTimestamp() string })
+	if !ok || e.GetTimestamp() == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, e.GetTimestamp())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// EventTime returns when an AGS event occurred, for stamping its signal and bucketing it
+// by time. Events without a valid timestamp are taken to occur now, and timestamps ahead
+// of now (producer clock skew) are clamped to now.
+func EventTime(event interface{}, now time.Time) time.Time {
+	t, ok := ParseEventTime(event)
+	if !ok || t.After(now) {
+		return now
+	}
+	return t
+}
@@ -0,0 +1,37 @@
+package signal
+
+import (
+	"testing"
+	"time"
+
+	asyncapi_iam "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
+)
+
+func TestEventTime(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		timestamp string
+		want      time.Time
+	}{
+		{"RFC 3339", "2026-03-01T08:30:00Z", time.Date(2026, 3, 1, 8, 30, 0, 0, time.UTC)},
+		{"fractional seconds", "2026-03-01T08:30:00.250Z", time.Date(2026, 3, 1, 8, 30, 0, 250_000_000, time.UTC)},
+		{"missing", "", now},
+		{"invalid", "yesterday", now},
+		{"ahead of the clock", "2026-03-05T12:00:00Z", now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EventTime(&asyncapi_iam.OauthTokenGenerated{Timestamp: tt.timestamp}, now)
+			if !got.Equal(tt.want) {
+				t.Errorf("EventTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := EventTime(struct{}{}, now); !got.Equal(now) {
+		t.Errorf("EventTime() of an event without timestamp = %v, want %v", got, now)
+	}
+}
@@ -1,22 +1,57 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
 )
 
-// BuildPlayerContext creates a PlayerContext from churn state.
+// BuildPlayerContext creates a PlayerContext from churn state as of now, normally the time of the event.
 // This helper is used by event processors that need to enrich signals with player context.
-func BuildPlayerContext(userID, namespace string, churnState *service.ChurnState) *PlayerContext {
+func BuildPlayerContext(userID, namespace string, churnState *service.ChurnState, now time.Time) *PlayerContext {
 	playerContext := &PlayerContext{
 		UserID:      userID,
-		State:       churnState,
 		Namespace:   namespace,
 		SessionInfo: make(map[string]interface{}),
 	}
 
-	// Add churn state metadata
-	playerContext.SessionInfo["active_interventions"] = len(churnState.GetActiveInterventions())
-	playerContext.SessionInfo["on_cooldown"] = churnState.Cooldown.IsOnCooldown()
+	playerContext.SetState(churnState, now)
 
 	return playerContext
 }
+
+// Clone returns a copy of the context with its own copy of the churn state, for work that
+// runs outside the event's lane, such as async actions, so that it cannot race with the
+// events processed after it.
+func (c *PlayerContext) Clone() *PlayerContext {
+	if c == nil {
+		return nil
+	}
+
+	clone := &PlayerContext{
+		UserID:      c.UserID,
+		Namespace:   c.Namespace,
+		SessionInfo: make(map[string]interface{}, len(c.SessionInfo)),
+	}
+	for key, value := range c.SessionInfo {
+		clone.SessionInfo[key] = value
+	}
+	if c.State != nil {
+		clone.State = c.State.Clone()
+	}
+	return clone
+}
+
+// SetState replaces the churn state on the context and refreshes the derived session info as of now.
+// This is used when the state is reloaded after a concurrent modification.
+func (c *PlayerContext) SetState(churnState *service.ChurnState, now time.Time) {
+	c.State = churnState
+
+	if c.SessionInfo == nil {
+		c.SessionInfo = make(map[string]interface{})
+	}
+
+	// Add churn state metadata
+	c.SessionInfo["active_interventions"] = len(churnState.GetActiveInterventions(now))
+	c.SessionInfo["on_cooldown"] = churnState.Cooldown.IsOnCooldown(now)
+}
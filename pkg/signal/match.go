@@ -0,0 +1,153 @@
+package signal
+
+import (
+	"strconv"
+
+	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Metadata keys of stat signals describing the stat update and the match it happened in.
+// Match keys are only set when the game server attached the field to the stat update.
+const (
+	MetadataInc          = "inc"
+	MetadataRequestValue = "request_value"
+	MetadataMatchID      = "match_id"
+	MetadataMatchMode    = "match_mode"
+	MetadataMatchMap     = "match_map"
+	MetadataOpponentMMR  = "opponent_mmr"
+	// MetadataMatch holds all of additional_data, for fields without a typed key
+	MetadataMatch = "match"
+)
+
+// matchMetadataKeys are the metadata keys of the match context.
+var matchMetadataKeys = []string{MetadataMatchID, MetadataMatchMode, MetadataMatchMap, MetadataOpponentMMR, MetadataMatch}
+
+// additional_data keys of the match context fields. Game servers use snake_case or camelCase.
+var (
+	matchIDKeys     = []string{"match_id", "matchId"}
+	matchModeKeys   = []string{"mode", "match_mode", "matchMode", "game_mode", "gameMode"}
+	matchMapKeys    = []string{"map", "match_map", "matchMap", "map_name", "mapName"}
+	opponentMMRKeys = []string{"opponent_mmr", "opponentMmr", "opponentMMR"}
+)
+
+// MatchContext is the match a stat update happened in, decoded from the additional_data
+// game servers attach to stat updates. Fields that were not attached are empty.
+type MatchContext struct {
+	MatchID     string
+	Mode        string
+	Map         string
+	OpponentMMR *float64
+	// Data is all of additional_data, including fields without a typed field above
+	Data map[string]interface{}
+}
+
+// DecodeMatchContext decodes the match context from the additional_data of a stat update.
+// Returns nil if there is no additional data.
+func DecodeMatchContext(additionalData *structpb.Struct) *MatchContext {
+	if len(additionalData.GetFields()) == 0 {
+		return nil
+	}
+
+	data := additionalData.AsMap()
+	match := &MatchContext{
+		MatchID: lookupString(data, matchIDKeys),
+		Mode:    lookupString(data, matchModeKeys),
+		Map:     lookupString(data, matchMapKeys),
+		Data:    data,
+	}
+	if mmr, ok := lookupNumber(data, opponentMMRKeys); ok {
+		match.OpponentMMR = &mmr
+	}
+	return match
+}
+
+// AddMetadata adds the match context to signal metadata, so that rule conditions can
+// narrow on it, e.g. `has(signal.match_mode) && signal.match_mode == "ranked"`.
+func (m *MatchContext) AddMetadata(metadata map[string]interface{}) {
+	if m == nil {
+		return
+	}
+
+	if m.MatchID != "" {
+		metadata[MetadataMatchID] = m.MatchID
+	}
+	if m.Mode != "" {
+		metadata[MetadataMatchMode] = m.Mode
+	}
+	if m.Map != "" {
+		metadata[MetadataMatchMap] = m.Map
+	}
+	if m.OpponentMMR != nil {
+		metadata[MetadataOpponentMMR] = *m.OpponentMMR
+	}
+	metadata[MetadataMatch] = copyData(m.Data)
+}
+
+// AddStatItemMetadata adds the update of a stat item to signal metadata: its increment,
+// the value requested by the game server and the match context. Returns the match
+// context, nil if the update carries none.
+func AddStatItemMetadata(metadata map[string]interface{}, item *statistic.StatItem) *MatchContext {
+	metadata[MetadataInc] = item.GetInc()
+	metadata[MetadataRequestValue] = item.GetRequestValue()
+
+	match := DecodeMatchContext(item.GetAdditionalData())
+	match.AddMetadata(metadata)
+	return match
+}
+
+// CopyMatchMetadata copies the match context of a signal's metadata to dst, without
+// overwriting keys dst already has. The engine uses it so actions can reference the
+// match that caused a trigger.
+func CopyMatchMetadata(dst, src map[string]interface{}) {
+	for _, key := range matchMetadataKeys {
+		if value, ok := src[key]; ok {
+			if _, exists := dst[key]; !exists {
+				if data, ok := value.(map[string]interface{}); ok {
+					value = copyData(data)
+				}
+				dst[key] = value
+			}
+		}
+	}
+}
+
+// copyData returns a copy of the top level of additional data, so that metadata holding
+// it can be changed without changing the match context or other metadata.
+func copyData(data map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		copied[key] = value
+	}
+	return copied
+}
+
+// lookupString returns the first of keys set in data, formatting numbers as strings.
+func lookupString(data map[string]interface{}, keys []string) string {
+	for _, key := range keys {
+		switch v := data[key].(type) {
+		case string:
+			if v != "" {
+				return v
+			}
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	return ""
+}
+
+// lookupNumber returns the first of keys set in data, parsing numeric strings.
+func lookupNumber(data map[string]interface{}, keys []string) (float64, bool) {
+	for _, key := range keys {
+		switch v := data[key].(type) {
+		case float64:
+			return v, true
+		case string:
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
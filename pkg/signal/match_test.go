@@ -0,0 +1,100 @@
+package signal
+
+import (
+	"testing"
+
+	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDecodeMatchContext(t *testing.T) {
+	if match := DecodeMatchContext(nil); match != nil {
+		t.Errorf("expected nil match context without additional data, got %+v", match)
+	}
+
+	data, err := structpb.NewStruct(map[string]interface{}{
+		"matchId":      "match-42",
+		"game_mode":    "ranked",
+		"map":          "dust",
+		"opponent_mmr": "1850",
+		"region":       "eu",
+	})
+	if err != nil {
+		t.Fatalf("NewStruct() error = %v", err)
+	}
+
+	match := DecodeMatchContext(data)
+	if match.MatchID != "match-42" || match.Mode != "ranked" || match.Map != "dust" {
+		t.Errorf("unexpected match context %+v", match)
+	}
+	if match.OpponentMMR == nil || *match.OpponentMMR != 1850 {
+		t.Errorf("expected opponent MMR 1850, got %v", match.OpponentMMR)
+	}
+	if match.Data["region"] != "eu" {
+		t.Errorf("expected all additional data kept, got %v", match.Data)
+	}
+}
+
+func TestAddStatItemMetadata(t *testing.T) {
+	data, _ := structpb.NewStruct(map[string]interface{}{"match_id": 42.0, "mode": "casual"})
+	metadata := map[string]interface{}{}
+
+	match := AddStatItemMetadata(metadata, &statistic.StatItem{Inc: 1, RequestValue: 3, AdditionalData: data})
+	if match == nil {
+		t.Fatal("expected a match context")
+	}
+
+	if metadata[MetadataInc] != 1.0 || metadata[MetadataRequestValue] != 3.0 {
+		t.Errorf("unexpected update metadata %v", metadata)
+	}
+	if metadata[MetadataMatchID] != "42" || metadata[MetadataMatchMode] != "casual" {
+		t.Errorf("unexpected match metadata %v", metadata)
+	}
+	if _, ok := metadata[MetadataOpponentMMR]; ok {
+		t.Error("expected no opponent_mmr when it was not attached")
+	}
+
+	// Stat updates without additional data carry no match keys
+	metadata = map[string]interface{}{}
+	if match := AddStatItemMetadata(metadata, &statistic.StatItem{}); match != nil {
+		t.Errorf("expected no match context, got %+v", match)
+	}
+	if _, ok := metadata[MetadataMatch]; ok {
+		t.Errorf("expected no match metadata, got %v", metadata)
+	}
+}
+
+func TestCopyMatchMetadata(t *testing.T) {
+	dst := map[string]interface{}{MetadataMatchMode: "set by rule"}
+	CopyMatchMetadata(dst, map[string]interface{}{
+		MetadataMatchID:   "match-42",
+		MetadataMatchMode: "ranked",
+		MetadataValue:     3.0,
+	})
+
+	if dst[MetadataMatchID] != "match-42" || dst[MetadataMatchMode] != "set by rule" {
+		t.Errorf("unexpected metadata %v", dst)
+	}
+	if _, ok := dst[MetadataValue]; ok {
+		t.Error("expected only match keys copied")
+	}
+}
+
+func TestMatchMetadata_CopiesData(t *testing.T) {
+	match := &MatchContext{MatchID: "match-42", Data: map[string]interface{}{"region": "eu"}}
+	metadata := map[string]interface{}{}
+	match.AddMetadata(metadata)
+
+	trigger := map[string]interface{}{}
+	CopyMatchMetadata(trigger, metadata)
+
+	// Each holder of the additional data has its own map
+	metadata[MetadataMatch].(map[string]interface{})["region"] = "us"
+	trigger[MetadataMatch].(map[string]interface{})["region"] = "ap"
+	if match.Data["region"] != "eu" {
+		t.Errorf("expected the match context unchanged, got %v", match.Data)
+	}
+	if metadata[MetadataMatch].(map[string]interface{})["region"] != "us" {
+		t.Errorf("expected the signal metadata unchanged by the trigger, got %v", metadata[MetadataMatch])
+	}
+}
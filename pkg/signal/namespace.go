@@ -0,0 +1,26 @@
+package signal
+
+import (
+	"context"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/namespace"
+)
+
+// EventNamespace returns the AGS namespace of an event, or defaultNamespace for events
+// that carry none.
+func EventNamespace(event interface{}, defaultNamespace string) string {
+	if e, ok := event.(interface{ GetNamespace() string }); ok && e.GetNamespace() != "" {
+		return e.GetNamespace()
+	}
+	return defaultNamespace
+}
+
+// Namespace returns the namespace of the event being processed, which the pipeline sets
+// on ctx, or defaultNamespace when ctx carries none (e.g. a processor called directly).
+// Event processors use it to build the PlayerContext of their signals.
+func Namespace(ctx context.Context, defaultNamespace string) string {
+	if ns := namespace.FromContext(ctx); ns != "" {
+		return ns
+	}
+	return defaultNamespace
+}
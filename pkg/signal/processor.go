@@ -3,29 +3,46 @@ package signal
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
 	oauth "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/iam/oauth/v1"
 	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
 	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer creates a span per processed event using the global tracer provider.
+var tracer = otel.Tracer("github.com/AccelByte/extend-churn-intervention/pkg/signal")
+
 // Processor converts raw events into domain signals with enriched context.
 type Processor struct {
 	stateStore             service.StateStore
 	eventProcessorRegistry *EventProcessorRegistry
 	namespace              string
+	clock                  clock.Clock
+	stats                  *processorStats
 }
 
-// NewProcessor creates a new signal processor.
+// NewProcessor creates a new signal processor reading the wall clock.
 func NewProcessor(stateStore service.StateStore, namespace string) *Processor {
 	return &Processor{
 		stateStore:             stateStore,
 		eventProcessorRegistry: NewEventProcessorRegistry(),
 		namespace:              namespace,
+		clock:                  clock.Real{},
+		stats:                  newProcessorStats(),
 	}
 }
 
+// SetClock replaces the clock that stands in for the time of events without a timestamp.
+// It must be called before processing starts.
+func (p *Processor) SetClock(c clock.Clock) {
+	p.clock = c
+}
+
 // GetEventProcessorRegistry returns the event processor registry.
 // This allows registering custom event processors.
 func (p *Processor) GetEventProcessorRegistry() *EventProcessorRegistry {
@@ -44,9 +61,28 @@ func (p *Processor) GetNamespace() string {
 	return p.namespace
 }
 
+// GetClock returns the clock of this processor.
+// This is useful for passing to event processors that need the current time.
+func (p *Processor) GetClock() clock.Clock {
+	return p.clock
+}
+
+// Stats returns a snapshot of the processed event counters.
+func (p *Processor) Stats() ProcessorStats {
+	return p.stats.snapshot()
+}
+
 // ProcessEvent processes any event type using registered event processors.
 // This is the generic entry point for all event processing.
 func (p *Processor) ProcessEvent(ctx context.Context, eventType string, event interface{}) (Signal, error) {
+	ctx, span := startProcessSpan(ctx, eventType)
+	sig, err := p.processEvent(ctx, eventType, event)
+	p.stats.record(eventType, sig, err)
+	endProcessSpan(span, sig, err)
+	return sig, err
+}
+
+func (p *Processor) processEvent(ctx context.Context, eventType string, event interface{}) (Signal, error) {
 	processor := p.eventProcessorRegistry.Get(eventType)
 	if processor == nil {
 		return nil, fmt.Errorf("no event processor registered for event type '%s'", eventType)
@@ -64,6 +100,58 @@ func (p *Processor) ProcessOAuthEvent(ctx context.Context, event *oauth.OauthTok
 // Routes to stat-code-specific event processors if registered,
 // otherwise falls back to a generic StatUpdateSignal.
 func (p *Processor) ProcessStatEvent(ctx context.Context, event *statistic.StatItemUpdated) (Signal, error) {
+	eventType := event.GetPayload().GetStatCode()
+	if eventType == "" {
+		eventType = "stat_item_updated"
+	}
+
+	ctx, span := startProcessSpan(ctx, eventType)
+	sig, err := p.processStatEvent(ctx, event)
+	p.stats.record(eventType, sig, err)
+	endProcessSpan(span, sig, err)
+
+	return sig, err
+}
+
+// SignalTypeForStat returns the type of the signals emitted for updates of statCode:
+// the type declared by its event processor (see SignalTyper), or TypeStatUpdate when no
+// processor handles the stat code. It returns false if the processor declares no type.
+func (p *Processor) SignalTypeForStat(statCode string) (string, bool) {
+	processor := p.eventProcessorRegistry.Get(statCode)
+	if processor == nil {
+		return TypeStatUpdate, true
+	}
+
+	typer, ok := processor.(SignalTyper)
+	if !ok {
+		return "", false
+	}
+	return typer.SignalType(), true
+}
+
+// startProcessSpan starts the span covering the conversion of one event into a signal.
+func startProcessSpan(ctx context.Context, eventType string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "signal.process", trace.WithAttributes(
+		attribute.String("event.type", eventType),
+	))
+}
+
+// endProcessSpan records the outcome of processing an event and ends its span.
+func endProcessSpan(span trace.Span, sig Signal, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	if sig != nil {
+		span.SetAttributes(
+			attribute.String("signal.type", sig.Type()),
+			attribute.String("user.id", sig.UserID()),
+		)
+	}
+	span.End()
+}
+
+func (p *Processor) processStatEvent(ctx context.Context, event *statistic.StatItemUpdated) (Signal, error) {
 	if event == nil {
 		return nil, fmt.Errorf("stat event is nil")
 	}
@@ -99,6 +187,9 @@ func (p *Processor) ProcessStatEvent(ctx context.Context, event *statistic.StatI
 		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
 	}
 
-	playerCtx := BuildPlayerContext(userID, p.namespace, churnState)
-	return NewStatUpdateSignal(userID, time.Now(), statCode, payload.GetLatestValue(), playerCtx), nil
+	at := EventTime(event, p.clock.Now())
+	playerCtx := BuildPlayerContext(userID, Namespace(ctx, p.namespace), churnState, at)
+	sig := NewStatUpdateSignal(userID, at, statCode, payload.GetLatestValue(), playerCtx)
+	AddStatItemMetadata(sig.metadata, payload)
+	return sig, nil
 }
@@ -44,6 +44,11 @@ func (m *mockStateStore) UpdateChurnState(ctx context.Context, userID string, ch
 	return nil
 }
 
+func (m *mockStateStore) DeleteChurnState(ctx context.Context, userID string) error {
+	delete(m.states, userID)
+	return nil
+}
+
 // setupTestProcessor creates a processor with test event processors registered
 func setupTestProcessor(stores ...service.StateStore) *Processor {
 	var store service.StateStore
@@ -104,7 +109,7 @@ func (p *testOAuthEventProcessor) Process(ctx context.Context, event interface{}
 		return nil, err
 	}
 
-	playerCtx := BuildPlayerContext(userID, p.namespace, churnState)
+	playerCtx := BuildPlayerContext(userID, p.namespace, churnState, time.Now())
 
 	metadata := map[string]interface{}{
 		"event": "oauth_token_generated",
@@ -144,7 +149,7 @@ func (p *testRageQuitEventProcessor) Process(ctx context.Context, event interfac
 		return nil, err
 	}
 
-	playerCtx := BuildPlayerContext(userID, p.namespace, churnState)
+	playerCtx := BuildPlayerContext(userID, p.namespace, churnState, time.Now())
 
 	value := statEvent.GetPayload().GetLatestValue()
 	metadata := map[string]interface{}{
@@ -186,7 +191,7 @@ func (p *testMatchWinEventProcessor) Process(ctx context.Context, event interfac
 		return nil, err
 	}
 
-	playerCtx := BuildPlayerContext(userID, p.namespace, churnState)
+	playerCtx := BuildPlayerContext(userID, p.namespace, churnState, time.Now())
 
 	value := statEvent.GetPayload().GetLatestValue()
 	metadata := map[string]interface{}{
@@ -228,7 +233,7 @@ func (p *testLosingStreakEventProcessor) Process(ctx context.Context, event inte
 		return nil, err
 	}
 
-	playerCtx := BuildPlayerContext(userID, p.namespace, churnState)
+	playerCtx := BuildPlayerContext(userID, p.namespace, churnState, time.Now())
 
 	value := statEvent.GetPayload().GetLatestValue()
 	metadata := map[string]interface{}{
@@ -486,6 +491,10 @@ func (e *errorStateStore) UpdateChurnState(ctx context.Context, userID string, c
 	return fmt.Errorf("mock state store error")
 }
 
+func (e *errorStateStore) DeleteChurnState(ctx context.Context, userID string) error {
+	return fmt.Errorf("mock state store error")
+}
+
 func TestProcessor_ProcessOAuthEvent_StateStoreError(t *testing.T) {
 	store := &errorStateStore{}
 	processor := setupTestProcessor(store)
@@ -516,3 +525,41 @@ func TestProcessor_ProcessStatEvent_StateStoreError(t *testing.T) {
 		t.Error("Expected error when state store fails")
 	}
 }
+
+func TestProcessor_Stats(t *testing.T) {
+	processor := setupTestProcessor()
+	ctx := context.Background()
+
+	rageQuit := &statistic.StatItemUpdated{
+		UserId:  "test-user-123",
+		Payload: &statistic.StatItem{StatCode: "rse-rage-quit", LatestValue: 5.0},
+	}
+	processor.ProcessStatEvent(ctx, rageQuit)
+	processor.ProcessStatEvent(ctx, rageQuit)
+	processor.ProcessStatEvent(ctx, &statistic.StatItemUpdated{
+		Payload: &statistic.StatItem{StatCode: "rse-rage-quit", LatestValue: 3.0},
+	})
+
+	stats := processor.Stats()
+	if stats.TotalEventsProcessed != 3 || stats.SignalsGenerated != 2 || stats.Errors != 1 {
+		t.Errorf("unexpected totals: %+v", stats)
+	}
+
+	want := EventTypeStats{EventsProcessed: 3, SignalsGenerated: 2, Errors: 1}
+	if got := stats.ByEventType["rse-rage-quit"]; got != want {
+		t.Errorf("expected rse-rage-quit stats %+v, got %+v", want, got)
+	}
+}
+
+func TestProcessor_SignalTypeForStat(t *testing.T) {
+	processor := NewProcessor(newMockStateStore(), "test-namespace")
+	processor.GetEventProcessorRegistry().Register(NewStatEventProcessor(
+		StatMapping{StatCode: "rse-match-abandoned", SignalType: "match_abandoned"}, newMockStateStore(), "test-namespace", nil))
+
+	if signalType, ok := processor.SignalTypeForStat("rse-match-abandoned"); !ok || signalType != "match_abandoned" {
+		t.Errorf("expected match_abandoned for a mapped stat, got %q, %v", signalType, ok)
+	}
+	if signalType, ok := processor.SignalTypeForStat("rse-unknown"); !ok || signalType != TypeStatUpdate {
+		t.Errorf("expected %s for an unhandled stat, got %q, %v", TypeStatUpdate, signalType, ok)
+	}
+}
@@ -0,0 +1,121 @@
+package signal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/extend-churn-intervention/pkg/clock"
+	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"github.com/AccelByte/extend-churn-intervention/pkg/service"
+)
+
+// Stat item fields a StatMapping can take the signal value from.
+const (
+	StatValueLatest = "latest_value" // The stat value after the update (default)
+	StatValueInc    = "inc"          // The increment applied by the update
+)
+
+// Metadata keys set on every mapped stat signal. additional_data fields cannot use them.
+const (
+	MetadataStatCode = "stat_code"
+	MetadataValue    = "value"
+)
+
+// IsReservedMetadataKey reports whether key is set by the processor on every mapped stat
+// signal, so that an additional_data field of that name must not be copied over it.
+func IsReservedMetadataKey(key string) bool {
+	switch key {
+	case MetadataStatCode, MetadataValue, MetadataInc, MetadataRequestValue:
+		return true
+	}
+	for _, matchKey := range matchMetadataKeys {
+		if key == matchKey {
+			return true
+		}
+	}
+	return false
+}
+
+// StatMapping declares how updates of a stat code become signals, so a stat can be
+// turned into a signal from configuration instead of a dedicated EventProcessor.
+type StatMapping struct {
+	StatCode   string   // Stat code whose updates are mapped
+	SignalType string   // Type of the emitted signals, matched by rules
+	Value      string   // StatValueLatest (default) or StatValueInc
+	Metadata   []string // additional_data fields copied into the signal metadata
+}
+
+// StatEventProcessor turns stat updates into signals as declared by a StatMapping.
+// The signals are StatUpdateSignals with the mapping's signal type; their metadata holds
+// the stat code, the value and the mapped additional_data fields present in the event.
+type StatEventProcessor struct {
+	mapping    StatMapping
+	stateStore service.StateStore
+	namespace  string
+	clock      clock.Clock
+}
+
+// NewStatEventProcessor creates an event processor for a stat mapping.
+// c stands in for the time of events without a timestamp; nil means the wall clock.
+func NewStatEventProcessor(mapping StatMapping, stateStore service.StateStore, namespace string, c clock.Clock) *StatEventProcessor {
+	return &StatEventProcessor{
+		mapping:    mapping,
+		stateStore: stateStore,
+		namespace:  namespace,
+		clock:      clock.OrReal(c),
+	}
+}
+
+// EventType returns the mapped stat code.
+func (p *StatEventProcessor) EventType() string {
+	return p.mapping.StatCode
+}
+
+// SignalType returns the type of the signals emitted by the processor.
+func (p *StatEventProcessor) SignalType() string {
+	return p.mapping.SignalType
+}
+
+// Mapping returns the stat mapping of the processor.
+func (p *StatEventProcessor) Mapping() StatMapping {
+	return p.mapping
+}
+
+func (p *StatEventProcessor) Process(ctx context.Context, event interface{}) (Signal, error) {
+	statEvent, ok := event.(*statistic.StatItemUpdated)
+	if !ok {
+		return nil, fmt.Errorf("expected *statistic.StatItemUpdated, got %T", event)
+	}
+
+	payload := statEvent.GetPayload()
+	userID := statEvent.GetUserId()
+	if userID == "" {
+		userID = payload.GetUserId()
+	}
+
+	value := payload.GetLatestValue()
+	if p.mapping.Value == StatValueInc {
+		value = payload.GetInc()
+	}
+
+	churnState, err := p.stateStore.GetChurnState(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load churn state for user %s: %w", userID, err)
+	}
+
+	at := EventTime(statEvent, p.clock.Now())
+	playerCtx := BuildPlayerContext(userID, Namespace(ctx, p.namespace), churnState, at)
+	sig := NewStatSignal(p.mapping.SignalType, userID, at, p.mapping.StatCode, value, playerCtx)
+	AddStatItemMetadata(sig.metadata, payload)
+
+	if len(p.mapping.Metadata) > 0 && payload.GetAdditionalData() != nil {
+		additionalData := payload.GetAdditionalData().AsMap()
+		for _, field := range p.mapping.Metadata {
+			if v, ok := additionalData[field]; ok && !IsReservedMetadataKey(field) {
+				sig.metadata[field] = v
+			}
+		}
+	}
+
+	return sig, nil
+}
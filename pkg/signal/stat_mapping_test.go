@@ -0,0 +1,117 @@
+package signal
+
+import (
+	"context"
+	"testing"
+
+	statistic "github.com/AccelByte/extend-churn-intervention/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStatEventProcessor(t *testing.T) {
+	additionalData, err := structpb.NewStruct(map[string]interface{}{
+		"game_mode": "ranked",
+		"map":       "harbor",
+		"party":     true,
+		"value":     99,
+		"match_map": "lobby",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := &statistic.StatItemUpdated{
+		Payload: &statistic.StatItem{
+			UserId:         "user123",
+			StatCode:       "rse-match-abandoned",
+			LatestValue:    4,
+			Inc:            1,
+			AdditionalData: additionalData,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mapping       StatMapping
+		expectedValue float64
+		expectedMeta  map[string]interface{}
+	}{
+		{
+			name:          "latest value",
+			mapping:       StatMapping{StatCode: "rse-match-abandoned", SignalType: "match_abandoned"},
+			expectedValue: 4,
+			expectedMeta: map[string]interface{}{MetadataStatCode: "rse-match-abandoned", MetadataValue: 4.0,
+				MetadataInc: 1.0, MetadataMatchMode: "ranked", MetadataMatchMap: "harbor"},
+		},
+		{
+			name: "increment with metadata",
+			mapping: StatMapping{StatCode: "rse-match-abandoned", SignalType: "match_abandoned",
+				Value: StatValueInc, Metadata: []string{"game_mode", "missing"}},
+			expectedValue: 1,
+			expectedMeta: map[string]interface{}{MetadataStatCode: "rse-match-abandoned", MetadataValue: 1.0,
+				MetadataInc: 1.0, MetadataMatchMode: "ranked", MetadataMatchMap: "harbor", "game_mode": "ranked"},
+		},
+		{
+			name: "reserved metadata fields",
+			mapping: StatMapping{StatCode: "rse-match-abandoned", SignalType: "match_abandoned",
+				Metadata: []string{MetadataValue, MetadataMatchMap}},
+			expectedValue: 4,
+			expectedMeta: map[string]interface{}{MetadataStatCode: "rse-match-abandoned", MetadataValue: 4.0,
+				MetadataMatchMap: "harbor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processor := NewStatEventProcessor(tt.mapping, newMockStateStore(), "test-namespace", nil)
+			if processor.EventType() != "rse-match-abandoned" {
+				t.Errorf("expected event type rse-match-abandoned, got %s", processor.EventType())
+			}
+
+			sig, err := processor.Process(context.Background(), event)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if sig.Type() != "match_abandoned" {
+				t.Errorf("expected signal type match_abandoned, got %s", sig.Type())
+			}
+			if sig.UserID() != "user123" {
+				t.Errorf("expected user ID from the payload, got %s", sig.UserID())
+			}
+			if sig.Context() == nil || sig.Context().Namespace != "test-namespace" {
+				t.Errorf("expected player context, got %+v", sig.Context())
+			}
+			if value := sig.(*StatUpdateSignal).Value; value != tt.expectedValue {
+				t.Errorf("expected value %v, got %v", tt.expectedValue, value)
+			}
+
+			metadata := sig.Metadata()
+			if _, ok := metadata["missing"]; ok {
+				t.Errorf("expected no metadata for fields absent from additional_data, got %v", metadata)
+			}
+			for key, expected := range tt.expectedMeta {
+				if metadata[key] != expected {
+					t.Errorf("expected metadata %s=%v, got %v", key, expected, metadata[key])
+				}
+			}
+		})
+	}
+}
+
+func TestProcessor_ProcessStatEvent_MappedStatCode(t *testing.T) {
+	processor := setupTestProcessor()
+	processor.GetEventProcessorRegistry().Register(NewStatEventProcessor(
+		StatMapping{StatCode: "rse-match-abandoned", SignalType: "match_abandoned"},
+		processor.GetStateStore(), processor.GetNamespace(), processor.GetClock()))
+
+	sig, err := processor.ProcessStatEvent(context.Background(), &statistic.StatItemUpdated{
+		UserId:  "user123",
+		Payload: &statistic.StatItem{StatCode: "rse-match-abandoned", LatestValue: 2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sig.Type() != "match_abandoned" {
+		t.Errorf("expected mapped signal type, got %s", sig.Type())
+	}
+}
@@ -0,0 +1,73 @@
+package signal
+
+import "time"
+
+// TypeStatReset is the signal type emitted when a player's stat is reset.
+const TypeStatReset = "stat_reset"
+
+// Reasons for a stat reset.
+const (
+	StatResetDeleted = "deleted"     // The player's stat item was deleted
+	StatResetCycle   = "cycle_reset" // The stat cycle (e.g. a season) was reset for the player
+)
+
+// MetadataReason is the metadata key holding the reason of a stat reset.
+const MetadataReason = "reason"
+
+// StatResetSignal reports that a player's stat was reset, so that what the pipeline derived
+// from earlier values of the stat no longer applies. The pipeline clears the player's rule
+// cooldowns and recorded signals for the signals of the stat before evaluating the signal
+// against rules, then evaluates Next.
+type StatResetSignal struct {
+	userID    string
+	timestamp time.Time
+	metadata  map[string]interface{}
+	context   *PlayerContext
+	StatCode  string
+	Reason    string
+
+	// Next is the signal of the stat's first value after the reset, or nil if the reset
+	// carries no value, as when the stat item was deleted.
+	Next Signal
+}
+
+// NewStatResetSignal creates a new stat reset signal.
+func NewStatResetSignal(userID string, timestamp time.Time, statCode, reason string, context *PlayerContext) *StatResetSignal {
+	metadata := map[string]interface{}{
+		MetadataStatCode: statCode,
+		MetadataReason:   reason,
+	}
+	return &StatResetSignal{
+		userID:    userID,
+		timestamp: timestamp,
+		metadata:  metadata,
+		context:   context,
+		StatCode:  statCode,
+		Reason:    reason,
+	}
+}
+
+// Type implements Signal interface.
+func (s *StatResetSignal) Type() string {
+	return TypeStatReset
+}
+
+// UserID implements Signal interface.
+func (s *StatResetSignal) UserID() string {
+	return s.userID
+}
+
+// Timestamp implements Signal interface.
+func (s *StatResetSignal) Timestamp() time.Time {
+	return s.timestamp
+}
+
+// Metadata implements Signal interface.
+func (s *StatResetSignal) Metadata() map[string]interface{} {
+	return s.metadata
+}
+
+// Context implements Signal interface.
+func (s *StatResetSignal) Context() *PlayerContext {
+	return s.context
+}
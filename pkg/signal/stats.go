@@ -0,0 +1,51 @@
+package signal
+
+import "github.com/AccelByte/extend-churn-intervention/pkg/stats"
+
+// ProcessorStats is a snapshot of the signal processor counters.
+type ProcessorStats struct {
+	TotalEventsProcessed int64                     `json:"total_events_processed"`
+	SignalsGenerated     int64                     `json:"signals_generated"`
+	Errors               int64                     `json:"errors"`
+	ByEventType          map[string]EventTypeStats `json:"by_event_type"` // Keyed by event type, or stat code for stat events
+}
+
+// EventTypeStats contains the signal processor counters of a single event type.
+type EventTypeStats struct {
+	EventsProcessed  int64 `json:"events_processed"`
+	SignalsGenerated int64 `json:"signals_generated"`
+	Errors           int64 `json:"errors"`
+}
+
+// processorStats maintains the live signal processor counters, by event type.
+type processorStats struct {
+	byEventType *stats.Counters[EventTypeStats]
+}
+
+func newProcessorStats() *processorStats {
+	return &processorStats{byEventType: stats.NewCounters[EventTypeStats]()}
+}
+
+// record counts a processed event and its outcome.
+func (s *processorStats) record(eventType string, sig Signal, err error) {
+	s.byEventType.Update(eventType, func(counters *EventTypeStats) {
+		counters.EventsProcessed++
+		switch {
+		case err != nil:
+			counters.Errors++
+		case sig != nil:
+			counters.SignalsGenerated++
+		}
+	})
+}
+
+// snapshot returns a copy of the counters with totals across event types.
+func (s *processorStats) snapshot() ProcessorStats {
+	snapshot := ProcessorStats{ByEventType: s.byEventType.Snapshot()}
+	for _, counters := range snapshot.ByEventType {
+		snapshot.TotalEventsProcessed += counters.EventsProcessed
+		snapshot.SignalsGenerated += counters.SignalsGenerated
+		snapshot.Errors += counters.Errors
+	}
+	return snapshot
+}
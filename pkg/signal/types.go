@@ -19,12 +19,18 @@ type StatUpdateSignal struct {
 
 // NewStatUpdateSignal creates a new stat update signal.
 func NewStatUpdateSignal(userID string, timestamp time.Time, statCode string, value float64, context *PlayerContext) *StatUpdateSignal {
+	return NewStatSignal(TypeStatUpdate, userID, timestamp, statCode, value, context)
+}
+
+// NewStatSignal creates a stat update signal with another type than TypeStatUpdate,
+// for event processors that emit stat values under their own signal type.
+func NewStatSignal(signalType, userID string, timestamp time.Time, statCode string, value float64, context *PlayerContext) *StatUpdateSignal {
 	metadata := map[string]interface{}{
-		"stat_code": statCode,
-		"value":     value,
+		MetadataStatCode: statCode,
+		MetadataValue:    value,
 	}
 	return &StatUpdateSignal{
-		signalType: TypeStatUpdate,
+		signalType: signalType,
 		userID:     userID,
 		timestamp:  timestamp,
 		metadata:   metadata,
@@ -0,0 +1,41 @@
+// Package stats keeps the per-key counters behind the Stats snapshots of the
+// pipeline stages, e.g. per rule in the rule engine or per action in the executor.
+package stats
+
+import "sync"
+
+// Counters maintains a set of counters T per key. It is safe for concurrent use.
+type Counters[T any] struct {
+	mu    sync.Mutex
+	byKey map[string]*T
+}
+
+// NewCounters creates an empty set of counters.
+func NewCounters[T any]() *Counters[T] {
+	return &Counters[T]{byKey: make(map[string]*T)}
+}
+
+// Update applies fn to the counters of key, starting from zero counters for a new key.
+func (c *Counters[T]) Update(key string, fn func(counters *T)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counters, ok := c.byKey[key]
+	if !ok {
+		counters = new(T)
+		c.byKey[key] = counters
+	}
+	fn(counters)
+}
+
+// Snapshot returns a copy of the counters of every key.
+func (c *Counters[T]) Snapshot() map[string]T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := make(map[string]T, len(c.byKey))
+	for key, counters := range c.byKey {
+		snapshot[key] = *counters
+	}
+	return snapshot
+}
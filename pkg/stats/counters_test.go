@@ -0,0 +1,40 @@
+package stats
+
+import (
+	"sync"
+	"testing"
+)
+
+type testCounters struct {
+	Hits   int64
+	Misses int64
+}
+
+func TestCounters(t *testing.T) {
+	counters := NewCounters[testCounters]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counters.Update("a", func(c *testCounters) { c.Hits++ })
+		}()
+	}
+	wg.Wait()
+	counters.Update("b", func(c *testCounters) { c.Misses++ })
+
+	snapshot := counters.Snapshot()
+	if len(snapshot) != 2 {
+		t.Fatalf("expected counters of 2 keys, got %v", snapshot)
+	}
+	if snapshot["a"] != (testCounters{Hits: 10}) || snapshot["b"] != (testCounters{Misses: 1}) {
+		t.Errorf("unexpected counters: %v", snapshot)
+	}
+
+	// The snapshot is a copy
+	counters.Update("a", func(c *testCounters) { c.Hits++ })
+	if snapshot["a"].Hits != 10 {
+		t.Errorf("expected the snapshot to be unaffected by updates, got %d hits", snapshot["a"].Hits)
+	}
+}
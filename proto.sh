@@ -10,6 +10,9 @@ find_all_proto_files() {
 
 PROTO_DIR="${1:-pkg/proto}"
 OUT_DIR="${2:-pkg/pb}"
+# google/api/annotations.proto and http.proto, needed for the admin API gateway.
+# Vendored in third_party/googleapis (see its README.md).
+GOOGLEAPIS_DIR="${GOOGLEAPIS_DIR:-third_party/googleapis}"
 
 # Clean previously generated files.
 rm -rf "${OUT_DIR:?}"/* && \
@@ -18,8 +21,17 @@ rm -rf "${OUT_DIR:?}"/* && \
 # Step 1: Generate Go code for ALL proto files
 protoc \
   -I "${PROTO_DIR}" \
+  -I "${GOOGLEAPIS_DIR}" \
   --go_out="${OUT_DIR}" \
   --go_opt=paths=source_relative \
   --go-grpc_out="${OUT_DIR}" \
   --go-grpc_opt=paths=source_relative,require_unimplemented_servers=false \
   $(find_all_proto_files)
+
+# Step 2: Generate HTTP/JSON gateway code for services with google.api.http annotations
+protoc \
+  -I "${PROTO_DIR}" \
+  -I "${GOOGLEAPIS_DIR}" \
+  --grpc-gateway_out="${OUT_DIR}" \
+  --grpc-gateway_opt=paths=source_relative \
+  $(grep -rl "google.api.http" "${PROTO_DIR}" --include="*.proto")